@@ -20,6 +20,111 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// like the steam lobby types
+type LobbyVisibility int32
+
+const (
+	LobbyVisibility_Public      LobbyVisibility = 0
+	LobbyVisibility_FriendsOnly LobbyVisibility = 1 // only the friends of the owner can see and join it
+	LobbyVisibility_Private     LobbyVisibility = 2 // only joinable with an invite, not listed
+	LobbyVisibility_Invisible   LobbyVisibility = 3 // the same as Private for the server
+)
+
+// Enum value maps for LobbyVisibility.
+var (
+	LobbyVisibility_name = map[int32]string{
+		0: "Public",
+		1: "FriendsOnly",
+		2: "Private",
+		3: "Invisible",
+	}
+	LobbyVisibility_value = map[string]int32{
+		"Public":      0,
+		"FriendsOnly": 1,
+		"Private":     2,
+		"Invisible":   3,
+	}
+)
+
+func (x LobbyVisibility) Enum() *LobbyVisibility {
+	p := new(LobbyVisibility)
+	*p = x
+	return p
+}
+
+func (x LobbyVisibility) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (LobbyVisibility) Descriptor() protoreflect.EnumDescriptor {
+	return file_message_proto_enumTypes[0].Descriptor()
+}
+
+func (LobbyVisibility) Type() protoreflect.EnumType {
+	return &file_message_proto_enumTypes[0]
+}
+
+func (x LobbyVisibility) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use LobbyVisibility.Descriptor instead.
+func (LobbyVisibility) EnumDescriptor() ([]byte, []int) {
+	return file_message_proto_rawDescGZIP(), []int{0}
+}
+
+type PresenceState int32
+
+const (
+	PresenceState_Offline  PresenceState = 0
+	PresenceState_Browsing PresenceState = 1 // online, not in a lobby
+	PresenceState_InLobby  PresenceState = 2
+	PresenceState_InGame   PresenceState = 3
+)
+
+// Enum value maps for PresenceState.
+var (
+	PresenceState_name = map[int32]string{
+		0: "Offline",
+		1: "Browsing",
+		2: "InLobby",
+		3: "InGame",
+	}
+	PresenceState_value = map[string]int32{
+		"Offline":  0,
+		"Browsing": 1,
+		"InLobby":  2,
+		"InGame":   3,
+	}
+)
+
+func (x PresenceState) Enum() *PresenceState {
+	p := new(PresenceState)
+	*p = x
+	return p
+}
+
+func (x PresenceState) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PresenceState) Descriptor() protoreflect.EnumDescriptor {
+	return file_message_proto_enumTypes[1].Descriptor()
+}
+
+func (PresenceState) Type() protoreflect.EnumType {
+	return &file_message_proto_enumTypes[1]
+}
+
+func (x PresenceState) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PresenceState.Descriptor instead.
+func (PresenceState) EnumDescriptor() ([]byte, []int) {
+	return file_message_proto_rawDescGZIP(), []int{1}
+}
+
 // the udp message is designed to be tiny, usually has a 2 byte header:
 //
 // 4bit + 2bit + 2bit + 8bit(or more than 8bit) + content
@@ -28,6 +133,9 @@ const (
 // 2 bit and 2 bit: src(0~3) dst(0~3)
 //
 // 8+bit: length(FF EF length = 0xFF + 0xEF)
+//
+// lobbies with more than 4 members use ForwardExtended for the positions above 3,
+// the src and dst are one byte each, following the first byte.
 type UdpMessageType int32
 
 const (
@@ -40,6 +148,8 @@ const (
 	UdpMessageType_ForwardOrYoursAndChannel UdpMessageType = 32
 	UdpMessageType_PingPong                 UdpMessageType = 48
 	UdpMessageType_EnsurePkg                UdpMessageType = 64
+	// |0b 0101 0000 | src(0~255) | dst(0~255) |  0~254(length) ......
+	UdpMessageType_ForwardExtended UdpMessageType = 80
 )
 
 // Enum value maps for UdpMessageType.
@@ -50,6 +160,7 @@ var (
 		32: "ForwardOrYoursAndChannel",
 		48: "PingPong",
 		64: "EnsurePkg",
+		80: "ForwardExtended",
 	}
 	UdpMessageType_value = map[string]int32{
 		"None":                     0,
@@ -57,6 +168,7 @@ var (
 		"ForwardOrYoursAndChannel": 32,
 		"PingPong":                 48,
 		"EnsurePkg":                64,
+		"ForwardExtended":          80,
 	}
 )
 
@@ -71,11 +183,11 @@ func (x UdpMessageType) String() string {
 }
 
 func (UdpMessageType) Descriptor() protoreflect.EnumDescriptor {
-	return file_message_proto_enumTypes[0].Descriptor()
+	return file_message_proto_enumTypes[2].Descriptor()
 }
 
 func (UdpMessageType) Type() protoreflect.EnumType {
-	return &file_message_proto_enumTypes[0]
+	return &file_message_proto_enumTypes[2]
 }
 
 func (x UdpMessageType) Number() protoreflect.EnumNumber {
@@ -84,7 +196,7 @@ func (x UdpMessageType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use UdpMessageType.Descriptor instead.
 func (UdpMessageType) EnumDescriptor() ([]byte, []int) {
-	return file_message_proto_rawDescGZIP(), []int{0}
+	return file_message_proto_rawDescGZIP(), []int{2}
 }
 
 type RequestHeader_RequestMessageType int32
@@ -105,6 +217,25 @@ const (
 	RequestHeader_AdminLogin          RequestHeader_RequestMessageType = 12
 	RequestHeader_GetServerUdpToken   RequestHeader_RequestMessageType = 13
 	RequestHeader_LogConsoleChat      RequestHeader_RequestMessageType = 14
+	RequestHeader_GlobalChatJoin      RequestHeader_RequestMessageType = 15
+	RequestHeader_GlobalChat          RequestHeader_RequestMessageType = 16
+	RequestHeader_Whisper             RequestHeader_RequestMessageType = 17
+	RequestHeader_LobbyKick           RequestHeader_RequestMessageType = 18
+	RequestHeader_LobbyBan            RequestHeader_RequestMessageType = 19
+	RequestHeader_LobbySetPassword    RequestHeader_RequestMessageType = 20
+	RequestHeader_LobbySetJoinable    RequestHeader_RequestMessageType = 21
+	RequestHeader_LobbyTransferOwner  RequestHeader_RequestMessageType = 22
+	RequestHeader_LobbySetSpectators  RequestHeader_RequestMessageType = 23
+	RequestHeader_LobbyListSubscribe  RequestHeader_RequestMessageType = 24
+	RequestHeader_Matchmake           RequestHeader_RequestMessageType = 25
+	RequestHeader_LobbyInviteCode     RequestHeader_RequestMessageType = 26
+	RequestHeader_JoinLobbyByCode     RequestHeader_RequestMessageType = 27
+	RequestHeader_UpdateLobbyData     RequestHeader_RequestMessageType = 28
+	RequestHeader_LobbyAnswerJoin     RequestHeader_RequestMessageType = 29
+	RequestHeader_Relation            RequestHeader_RequestMessageType = 30
+	RequestHeader_PresenceQuery       RequestHeader_RequestMessageType = 31
+	RequestHeader_PresenceSubscribe   RequestHeader_RequestMessageType = 32
+	RequestHeader_JoinPlayer          RequestHeader_RequestMessageType = 33
 )
 
 // Enum value maps for RequestHeader_RequestMessageType.
@@ -125,6 +256,25 @@ var (
 		12: "AdminLogin",
 		13: "GetServerUdpToken",
 		14: "LogConsoleChat",
+		15: "GlobalChatJoin",
+		16: "GlobalChat",
+		17: "Whisper",
+		18: "LobbyKick",
+		19: "LobbyBan",
+		20: "LobbySetPassword",
+		21: "LobbySetJoinable",
+		22: "LobbyTransferOwner",
+		23: "LobbySetSpectators",
+		24: "LobbyListSubscribe",
+		25: "Matchmake",
+		26: "LobbyInviteCode",
+		27: "JoinLobbyByCode",
+		28: "UpdateLobbyData",
+		29: "LobbyAnswerJoin",
+		30: "Relation",
+		31: "PresenceQuery",
+		32: "PresenceSubscribe",
+		33: "JoinPlayer",
 	}
 	RequestHeader_RequestMessageType_value = map[string]int32{
 		"Time":                0,
@@ -142,6 +292,25 @@ var (
 		"AdminLogin":          12,
 		"GetServerUdpToken":   13,
 		"LogConsoleChat":      14,
+		"GlobalChatJoin":      15,
+		"GlobalChat":          16,
+		"Whisper":             17,
+		"LobbyKick":           18,
+		"LobbyBan":            19,
+		"LobbySetPassword":    20,
+		"LobbySetJoinable":    21,
+		"LobbyTransferOwner":  22,
+		"LobbySetSpectators":  23,
+		"LobbyListSubscribe":  24,
+		"Matchmake":           25,
+		"LobbyInviteCode":     26,
+		"JoinLobbyByCode":     27,
+		"UpdateLobbyData":     28,
+		"LobbyAnswerJoin":     29,
+		"Relation":            30,
+		"PresenceQuery":       31,
+		"PresenceSubscribe":   32,
+		"JoinPlayer":          33,
 	}
 )
 
@@ -156,11 +325,11 @@ func (x RequestHeader_RequestMessageType) String() string {
 }
 
 func (RequestHeader_RequestMessageType) Descriptor() protoreflect.EnumDescriptor {
-	return file_message_proto_enumTypes[1].Descriptor()
+	return file_message_proto_enumTypes[3].Descriptor()
 }
 
 func (RequestHeader_RequestMessageType) Type() protoreflect.EnumType {
-	return &file_message_proto_enumTypes[1]
+	return &file_message_proto_enumTypes[3]
 }
 
 func (x RequestHeader_RequestMessageType) Number() protoreflect.EnumNumber {
@@ -172,6 +341,257 @@ func (RequestHeader_RequestMessageType) EnumDescriptor() ([]byte, []int) {
 	return file_message_proto_rawDescGZIP(), []int{1, 0}
 }
 
+type RequestLobbyList_LobbyListSort int32
+
+const (
+	RequestLobbyList_ById      RequestLobbyList_LobbyListSort = 0
+	RequestLobbyList_ByMembers RequestLobbyList_LobbyListSort = 1 // most members first
+	RequestLobbyList_ByName    RequestLobbyList_LobbyListSort = 2
+)
+
+// Enum value maps for RequestLobbyList_LobbyListSort.
+var (
+	RequestLobbyList_LobbyListSort_name = map[int32]string{
+		0: "ById",
+		1: "ByMembers",
+		2: "ByName",
+	}
+	RequestLobbyList_LobbyListSort_value = map[string]int32{
+		"ById":      0,
+		"ByMembers": 1,
+		"ByName":    2,
+	}
+)
+
+func (x RequestLobbyList_LobbyListSort) Enum() *RequestLobbyList_LobbyListSort {
+	p := new(RequestLobbyList_LobbyListSort)
+	*p = x
+	return p
+}
+
+func (x RequestLobbyList_LobbyListSort) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RequestLobbyList_LobbyListSort) Descriptor() protoreflect.EnumDescriptor {
+	return file_message_proto_enumTypes[4].Descriptor()
+}
+
+func (RequestLobbyList_LobbyListSort) Type() protoreflect.EnumType {
+	return &file_message_proto_enumTypes[4]
+}
+
+func (x RequestLobbyList_LobbyListSort) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RequestLobbyList_LobbyListSort.Descriptor instead.
+func (RequestLobbyList_LobbyListSort) EnumDescriptor() ([]byte, []int) {
+	return file_message_proto_rawDescGZIP(), []int{2, 0}
+}
+
+type RequestLobbyInviteCode_Action int32
+
+const (
+	RequestLobbyInviteCode_Get        RequestLobbyInviteCode_Action = 0 // create one if the lobby has no code
+	RequestLobbyInviteCode_Regenerate RequestLobbyInviteCode_Action = 1
+	RequestLobbyInviteCode_Revoke     RequestLobbyInviteCode_Action = 2
+)
+
+// Enum value maps for RequestLobbyInviteCode_Action.
+var (
+	RequestLobbyInviteCode_Action_name = map[int32]string{
+		0: "Get",
+		1: "Regenerate",
+		2: "Revoke",
+	}
+	RequestLobbyInviteCode_Action_value = map[string]int32{
+		"Get":        0,
+		"Regenerate": 1,
+		"Revoke":     2,
+	}
+)
+
+func (x RequestLobbyInviteCode_Action) Enum() *RequestLobbyInviteCode_Action {
+	p := new(RequestLobbyInviteCode_Action)
+	*p = x
+	return p
+}
+
+func (x RequestLobbyInviteCode_Action) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RequestLobbyInviteCode_Action) Descriptor() protoreflect.EnumDescriptor {
+	return file_message_proto_enumTypes[5].Descriptor()
+}
+
+func (RequestLobbyInviteCode_Action) Type() protoreflect.EnumType {
+	return &file_message_proto_enumTypes[5]
+}
+
+func (x RequestLobbyInviteCode_Action) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RequestLobbyInviteCode_Action.Descriptor instead.
+func (RequestLobbyInviteCode_Action) EnumDescriptor() ([]byte, []int) {
+	return file_message_proto_rawDescGZIP(), []int{5, 0}
+}
+
+type RequestRelation_Action int32
+
+const (
+	RequestRelation_List   RequestRelation_Action = 0
+	RequestRelation_Add    RequestRelation_Action = 1
+	RequestRelation_Remove RequestRelation_Action = 2
+)
+
+// Enum value maps for RequestRelation_Action.
+var (
+	RequestRelation_Action_name = map[int32]string{
+		0: "List",
+		1: "Add",
+		2: "Remove",
+	}
+	RequestRelation_Action_value = map[string]int32{
+		"List":   0,
+		"Add":    1,
+		"Remove": 2,
+	}
+)
+
+func (x RequestRelation_Action) Enum() *RequestRelation_Action {
+	p := new(RequestRelation_Action)
+	*p = x
+	return p
+}
+
+func (x RequestRelation_Action) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RequestRelation_Action) Descriptor() protoreflect.EnumDescriptor {
+	return file_message_proto_enumTypes[6].Descriptor()
+}
+
+func (RequestRelation_Action) Type() protoreflect.EnumType {
+	return &file_message_proto_enumTypes[6]
+}
+
+func (x RequestRelation_Action) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RequestRelation_Action.Descriptor instead.
+func (RequestRelation_Action) EnumDescriptor() ([]byte, []int) {
+	return file_message_proto_rawDescGZIP(), []int{10, 0}
+}
+
+type RequestRelation_Kind int32
+
+const (
+	RequestRelation_Friend RequestRelation_Kind = 0
+	RequestRelation_Block  RequestRelation_Kind = 1
+)
+
+// Enum value maps for RequestRelation_Kind.
+var (
+	RequestRelation_Kind_name = map[int32]string{
+		0: "Friend",
+		1: "Block",
+	}
+	RequestRelation_Kind_value = map[string]int32{
+		"Friend": 0,
+		"Block":  1,
+	}
+)
+
+func (x RequestRelation_Kind) Enum() *RequestRelation_Kind {
+	p := new(RequestRelation_Kind)
+	*p = x
+	return p
+}
+
+func (x RequestRelation_Kind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RequestRelation_Kind) Descriptor() protoreflect.EnumDescriptor {
+	return file_message_proto_enumTypes[7].Descriptor()
+}
+
+func (RequestRelation_Kind) Type() protoreflect.EnumType {
+	return &file_message_proto_enumTypes[7]
+}
+
+func (x RequestRelation_Kind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RequestRelation_Kind.Descriptor instead.
+func (RequestRelation_Kind) EnumDescriptor() ([]byte, []int) {
+	return file_message_proto_rawDescGZIP(), []int{10, 1}
+}
+
+type LobbyListFilter_Comparison int32
+
+const (
+	LobbyListFilter_Equal              LobbyListFilter_Comparison = 0
+	LobbyListFilter_NotEqual           LobbyListFilter_Comparison = 1
+	LobbyListFilter_LessThan           LobbyListFilter_Comparison = 2
+	LobbyListFilter_LessThanOrEqual    LobbyListFilter_Comparison = 3
+	LobbyListFilter_GreaterThan        LobbyListFilter_Comparison = 4
+	LobbyListFilter_GreaterThanOrEqual LobbyListFilter_Comparison = 5
+)
+
+// Enum value maps for LobbyListFilter_Comparison.
+var (
+	LobbyListFilter_Comparison_name = map[int32]string{
+		0: "Equal",
+		1: "NotEqual",
+		2: "LessThan",
+		3: "LessThanOrEqual",
+		4: "GreaterThan",
+		5: "GreaterThanOrEqual",
+	}
+	LobbyListFilter_Comparison_value = map[string]int32{
+		"Equal":              0,
+		"NotEqual":           1,
+		"LessThan":           2,
+		"LessThanOrEqual":    3,
+		"GreaterThan":        4,
+		"GreaterThanOrEqual": 5,
+	}
+)
+
+func (x LobbyListFilter_Comparison) Enum() *LobbyListFilter_Comparison {
+	p := new(LobbyListFilter_Comparison)
+	*p = x
+	return p
+}
+
+func (x LobbyListFilter_Comparison) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (LobbyListFilter_Comparison) Descriptor() protoreflect.EnumDescriptor {
+	return file_message_proto_enumTypes[8].Descriptor()
+}
+
+func (LobbyListFilter_Comparison) Type() protoreflect.EnumType {
+	return &file_message_proto_enumTypes[8]
+}
+
+func (x LobbyListFilter_Comparison) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use LobbyListFilter_Comparison.Descriptor instead.
+func (LobbyListFilter_Comparison) EnumDescriptor() ([]byte, []int) {
+	return file_message_proto_rawDescGZIP(), []int{14, 0}
+}
+
 type RequestLogin_Lang int32
 
 const (
@@ -202,11 +622,11 @@ func (x RequestLogin_Lang) String() string {
 }
 
 func (RequestLogin_Lang) Descriptor() protoreflect.EnumDescriptor {
-	return file_message_proto_enumTypes[2].Descriptor()
+	return file_message_proto_enumTypes[9].Descriptor()
 }
 
 func (RequestLogin_Lang) Type() protoreflect.EnumType {
-	return &file_message_proto_enumTypes[2]
+	return &file_message_proto_enumTypes[9]
 }
 
 func (x RequestLogin_Lang) Number() protoreflect.EnumNumber {
@@ -215,7 +635,7 @@ func (x RequestLogin_Lang) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use RequestLogin_Lang.Descriptor instead.
 func (RequestLogin_Lang) EnumDescriptor() ([]byte, []int) {
-	return file_message_proto_rawDescGZIP(), []int{3, 0}
+	return file_message_proto_rawDescGZIP(), []int{15, 0}
 }
 
 type RequestSendP2PPackage_EP2PSend int32
@@ -254,11 +674,11 @@ func (x RequestSendP2PPackage_EP2PSend) String() string {
 }
 
 func (RequestSendP2PPackage_EP2PSend) Descriptor() protoreflect.EnumDescriptor {
-	return file_message_proto_enumTypes[3].Descriptor()
+	return file_message_proto_enumTypes[10].Descriptor()
 }
 
 func (RequestSendP2PPackage_EP2PSend) Type() protoreflect.EnumType {
-	return &file_message_proto_enumTypes[3]
+	return &file_message_proto_enumTypes[10]
 }
 
 func (x RequestSendP2PPackage_EP2PSend) Number() protoreflect.EnumNumber {
@@ -267,7 +687,7 @@ func (x RequestSendP2PPackage_EP2PSend) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use RequestSendP2PPackage_EP2PSend.Descriptor instead.
 func (RequestSendP2PPackage_EP2PSend) EnumDescriptor() ([]byte, []int) {
-	return file_message_proto_rawDescGZIP(), []int{8, 0}
+	return file_message_proto_rawDescGZIP(), []int{20, 0}
 }
 
 type ResponseHeader_ResponseMessageType int32
@@ -290,6 +710,21 @@ const (
 	ResponseHeader_UpdateUserUdpIpAddr              ResponseHeader_ResponseMessageType = 14
 	ResponseHeader_UpdateLogConsoleChatFastMessages ResponseHeader_ResponseMessageType = 15
 	ResponseHeader_UpdateCreateRoomNameLists        ResponseHeader_ResponseMessageType = 16
+	ResponseHeader_GlobalChat                       ResponseHeader_ResponseMessageType = 17
+	ResponseHeader_GlobalChatStatus                 ResponseHeader_ResponseMessageType = 18
+	ResponseHeader_Whisper                          ResponseHeader_ResponseMessageType = 19
+	ResponseHeader_LobbyOwnerChanged                ResponseHeader_ResponseMessageType = 20
+	ResponseHeader_MessageSizeLimits                ResponseHeader_ResponseMessageType = 21
+	ResponseHeader_RequestFailed                    ResponseHeader_ResponseMessageType = 22
+	ResponseHeader_LobbyListDelta                   ResponseHeader_ResponseMessageType = 23
+	ResponseHeader_MatchmakeStatus                  ResponseHeader_ResponseMessageType = 24
+	ResponseHeader_LobbyInviteCode                  ResponseHeader_ResponseMessageType = 25
+	ResponseHeader_UpdateLobbyData                  ResponseHeader_ResponseMessageType = 26
+	ResponseHeader_LobbyJoinRequest                 ResponseHeader_ResponseMessageType = 27
+	ResponseHeader_RelationList                     ResponseHeader_ResponseMessageType = 28
+	ResponseHeader_FriendPresence                   ResponseHeader_ResponseMessageType = 29
+	ResponseHeader_Presence                         ResponseHeader_ResponseMessageType = 30
+	ResponseHeader_PresenceUpdate                   ResponseHeader_ResponseMessageType = 31
 )
 
 // Enum value maps for ResponseHeader_ResponseMessageType.
@@ -312,6 +747,21 @@ var (
 		14: "UpdateUserUdpIpAddr",
 		15: "UpdateLogConsoleChatFastMessages",
 		16: "UpdateCreateRoomNameLists",
+		17: "GlobalChat",
+		18: "GlobalChatStatus",
+		19: "Whisper",
+		20: "LobbyOwnerChanged",
+		21: "MessageSizeLimits",
+		22: "RequestFailed",
+		23: "LobbyListDelta",
+		24: "MatchmakeStatus",
+		25: "LobbyInviteCode",
+		26: "UpdateLobbyData",
+		27: "LobbyJoinRequest",
+		28: "RelationList",
+		29: "FriendPresence",
+		30: "Presence",
+		31: "PresenceUpdate",
 	}
 	ResponseHeader_ResponseMessageType_value = map[string]int32{
 		"Time":                             0,
@@ -331,6 +781,21 @@ var (
 		"UpdateUserUdpIpAddr":              14,
 		"UpdateLogConsoleChatFastMessages": 15,
 		"UpdateCreateRoomNameLists":        16,
+		"GlobalChat":                       17,
+		"GlobalChatStatus":                 18,
+		"Whisper":                          19,
+		"LobbyOwnerChanged":                20,
+		"MessageSizeLimits":                21,
+		"RequestFailed":                    22,
+		"LobbyListDelta":                   23,
+		"MatchmakeStatus":                  24,
+		"LobbyInviteCode":                  25,
+		"UpdateLobbyData":                  26,
+		"LobbyJoinRequest":                 27,
+		"RelationList":                     28,
+		"FriendPresence":                   29,
+		"Presence":                         30,
+		"PresenceUpdate":                   31,
 	}
 )
 
@@ -345,11 +810,11 @@ func (x ResponseHeader_ResponseMessageType) String() string {
 }
 
 func (ResponseHeader_ResponseMessageType) Descriptor() protoreflect.EnumDescriptor {
-	return file_message_proto_enumTypes[4].Descriptor()
+	return file_message_proto_enumTypes[11].Descriptor()
 }
 
 func (ResponseHeader_ResponseMessageType) Type() protoreflect.EnumType {
-	return &file_message_proto_enumTypes[4]
+	return &file_message_proto_enumTypes[11]
 }
 
 func (x ResponseHeader_ResponseMessageType) Number() protoreflect.EnumNumber {
@@ -358,7 +823,120 @@ func (x ResponseHeader_ResponseMessageType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use ResponseHeader_ResponseMessageType.Descriptor instead.
 func (ResponseHeader_ResponseMessageType) EnumDescriptor() ([]byte, []int) {
-	return file_message_proto_rawDescGZIP(), []int{11, 0}
+	return file_message_proto_rawDescGZIP(), []int{32, 0}
+}
+
+type ResponseRequestFailed_Reason int32
+
+const (
+	ResponseRequestFailed_Unknown       ResponseRequestFailed_Reason = 0
+	ResponseRequestFailed_TooLarge      ResponseRequestFailed_Reason = 1
+	ResponseRequestFailed_QuotaExceeded ResponseRequestFailed_Reason = 2 // too many lobby data or member data keys, or too many lobbies owned by the user
+	ResponseRequestFailed_InvalidData   ResponseRequestFailed_Reason = 3 // the key or the value is too long
+	ResponseRequestFailed_Forbidden     ResponseRequestFailed_Reason = 4 // the key is reserved
+	ResponseRequestFailed_RateLimited   ResponseRequestFailed_Reason = 5 // the request is sent too often, try again later
+	ResponseRequestFailed_ServerFull    ResponseRequestFailed_Reason = 6 // the server can't hold more lobbies
+)
+
+// Enum value maps for ResponseRequestFailed_Reason.
+var (
+	ResponseRequestFailed_Reason_name = map[int32]string{
+		0: "Unknown",
+		1: "TooLarge",
+		2: "QuotaExceeded",
+		3: "InvalidData",
+		4: "Forbidden",
+		5: "RateLimited",
+		6: "ServerFull",
+	}
+	ResponseRequestFailed_Reason_value = map[string]int32{
+		"Unknown":       0,
+		"TooLarge":      1,
+		"QuotaExceeded": 2,
+		"InvalidData":   3,
+		"Forbidden":     4,
+		"RateLimited":   5,
+		"ServerFull":    6,
+	}
+)
+
+func (x ResponseRequestFailed_Reason) Enum() *ResponseRequestFailed_Reason {
+	p := new(ResponseRequestFailed_Reason)
+	*p = x
+	return p
+}
+
+func (x ResponseRequestFailed_Reason) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ResponseRequestFailed_Reason) Descriptor() protoreflect.EnumDescriptor {
+	return file_message_proto_enumTypes[12].Descriptor()
+}
+
+func (ResponseRequestFailed_Reason) Type() protoreflect.EnumType {
+	return &file_message_proto_enumTypes[12]
+}
+
+func (x ResponseRequestFailed_Reason) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ResponseRequestFailed_Reason.Descriptor instead.
+func (ResponseRequestFailed_Reason) EnumDescriptor() ([]byte, []int) {
+	return file_message_proto_rawDescGZIP(), []int{34, 0}
+}
+
+type ResponseMatchmakeStatus_Status int32
+
+const (
+	ResponseMatchmakeStatus_Queued    ResponseMatchmakeStatus_Status = 0
+	ResponseMatchmakeStatus_Matched   ResponseMatchmakeStatus_Status = 1
+	ResponseMatchmakeStatus_Cancelled ResponseMatchmakeStatus_Status = 2
+	ResponseMatchmakeStatus_Failed    ResponseMatchmakeStatus_Status = 3
+)
+
+// Enum value maps for ResponseMatchmakeStatus_Status.
+var (
+	ResponseMatchmakeStatus_Status_name = map[int32]string{
+		0: "Queued",
+		1: "Matched",
+		2: "Cancelled",
+		3: "Failed",
+	}
+	ResponseMatchmakeStatus_Status_value = map[string]int32{
+		"Queued":    0,
+		"Matched":   1,
+		"Cancelled": 2,
+		"Failed":    3,
+	}
+)
+
+func (x ResponseMatchmakeStatus_Status) Enum() *ResponseMatchmakeStatus_Status {
+	p := new(ResponseMatchmakeStatus_Status)
+	*p = x
+	return p
+}
+
+func (x ResponseMatchmakeStatus_Status) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ResponseMatchmakeStatus_Status) Descriptor() protoreflect.EnumDescriptor {
+	return file_message_proto_enumTypes[13].Descriptor()
+}
+
+func (ResponseMatchmakeStatus_Status) Type() protoreflect.EnumType {
+	return &file_message_proto_enumTypes[13]
+}
+
+func (x ResponseMatchmakeStatus_Status) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ResponseMatchmakeStatus_Status.Descriptor instead.
+func (ResponseMatchmakeStatus_Status) EnumDescriptor() ([]byte, []int) {
+	return file_message_proto_rawDescGZIP(), []int{44, 0}
 }
 
 type ResponseLobbyChatUpdate_ChatMemberStateChange int32
@@ -403,11 +981,11 @@ func (x ResponseLobbyChatUpdate_ChatMemberStateChange) String() string {
 }
 
 func (ResponseLobbyChatUpdate_ChatMemberStateChange) Descriptor() protoreflect.EnumDescriptor {
-	return file_message_proto_enumTypes[5].Descriptor()
+	return file_message_proto_enumTypes[14].Descriptor()
 }
 
 func (ResponseLobbyChatUpdate_ChatMemberStateChange) Type() protoreflect.EnumType {
-	return &file_message_proto_enumTypes[5]
+	return &file_message_proto_enumTypes[14]
 }
 
 func (x ResponseLobbyChatUpdate_ChatMemberStateChange) Number() protoreflect.EnumNumber {
@@ -416,7 +994,7 @@ func (x ResponseLobbyChatUpdate_ChatMemberStateChange) Number() protoreflect.Enu
 
 // Deprecated: Use ResponseLobbyChatUpdate_ChatMemberStateChange.Descriptor instead.
 func (ResponseLobbyChatUpdate_ChatMemberStateChange) EnumDescriptor() ([]byte, []int) {
-	return file_message_proto_rawDescGZIP(), []int{24, 0}
+	return file_message_proto_rawDescGZIP(), []int{61, 0}
 }
 
 type ResponseLobbyJoin_EChatRoomEnterResponse int32
@@ -479,11 +1057,11 @@ func (x ResponseLobbyJoin_EChatRoomEnterResponse) String() string {
 }
 
 func (ResponseLobbyJoin_EChatRoomEnterResponse) Descriptor() protoreflect.EnumDescriptor {
-	return file_message_proto_enumTypes[6].Descriptor()
+	return file_message_proto_enumTypes[15].Descriptor()
 }
 
 func (ResponseLobbyJoin_EChatRoomEnterResponse) Type() protoreflect.EnumType {
-	return &file_message_proto_enumTypes[6]
+	return &file_message_proto_enumTypes[15]
 }
 
 func (x ResponseLobbyJoin_EChatRoomEnterResponse) Number() protoreflect.EnumNumber {
@@ -492,7 +1070,7 @@ func (x ResponseLobbyJoin_EChatRoomEnterResponse) Number() protoreflect.EnumNumb
 
 // Deprecated: Use ResponseLobbyJoin_EChatRoomEnterResponse.Descriptor instead.
 func (ResponseLobbyJoin_EChatRoomEnterResponse) EnumDescriptor() ([]byte, []int) {
-	return file_message_proto_rawDescGZIP(), []int{25, 0}
+	return file_message_proto_rawDescGZIP(), []int{62, 0}
 }
 
 type ResponseServerPublicMessage_PublicMessageType int32
@@ -528,11 +1106,11 @@ func (x ResponseServerPublicMessage_PublicMessageType) String() string {
 }
 
 func (ResponseServerPublicMessage_PublicMessageType) Descriptor() protoreflect.EnumDescriptor {
-	return file_message_proto_enumTypes[7].Descriptor()
+	return file_message_proto_enumTypes[16].Descriptor()
 }
 
 func (ResponseServerPublicMessage_PublicMessageType) Type() protoreflect.EnumType {
-	return &file_message_proto_enumTypes[7]
+	return &file_message_proto_enumTypes[16]
 }
 
 func (x ResponseServerPublicMessage_PublicMessageType) Number() protoreflect.EnumNumber {
@@ -541,7 +1119,62 @@ func (x ResponseServerPublicMessage_PublicMessageType) Number() protoreflect.Enu
 
 // Deprecated: Use ResponseServerPublicMessage_PublicMessageType.Descriptor instead.
 func (ResponseServerPublicMessage_PublicMessageType) EnumDescriptor() ([]byte, []int) {
-	return file_message_proto_rawDescGZIP(), []int{28, 0}
+	return file_message_proto_rawDescGZIP(), []int{65, 0}
+}
+
+type ResponseWhisper_WhisperResult int32
+
+const (
+	ResponseWhisper_Delivered     ResponseWhisper_WhisperResult = 0
+	ResponseWhisper_Offline       ResponseWhisper_WhisperResult = 1
+	ResponseWhisper_Blocked       ResponseWhisper_WhisperResult = 2
+	ResponseWhisper_Rejected      ResponseWhisper_WhisperResult = 3
+	ResponseWhisper_InvalidTarget ResponseWhisper_WhisperResult = 4 // the user whispers to itself
+)
+
+// Enum value maps for ResponseWhisper_WhisperResult.
+var (
+	ResponseWhisper_WhisperResult_name = map[int32]string{
+		0: "Delivered",
+		1: "Offline",
+		2: "Blocked",
+		3: "Rejected",
+		4: "InvalidTarget",
+	}
+	ResponseWhisper_WhisperResult_value = map[string]int32{
+		"Delivered":     0,
+		"Offline":       1,
+		"Blocked":       2,
+		"Rejected":      3,
+		"InvalidTarget": 4,
+	}
+)
+
+func (x ResponseWhisper_WhisperResult) Enum() *ResponseWhisper_WhisperResult {
+	p := new(ResponseWhisper_WhisperResult)
+	*p = x
+	return p
+}
+
+func (x ResponseWhisper_WhisperResult) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ResponseWhisper_WhisperResult) Descriptor() protoreflect.EnumDescriptor {
+	return file_message_proto_enumTypes[17].Descriptor()
+}
+
+func (ResponseWhisper_WhisperResult) Type() protoreflect.EnumType {
+	return &file_message_proto_enumTypes[17]
+}
+
+func (x ResponseWhisper_WhisperResult) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ResponseWhisper_WhisperResult.Descriptor instead.
+func (ResponseWhisper_WhisperResult) EnumDescriptor() ([]byte, []int) {
+	return file_message_proto_rawDescGZIP(), []int{70, 0}
 }
 
 type ProtoVersion struct {
@@ -659,7 +1292,14 @@ type RequestLobbyList struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	LobbyGroup int32 `protobuf:"varint,1,opt,name=lobbyGroup,proto3" json:"lobbyGroup,omitempty"`
+	LobbyGroup   int32                          `protobuf:"varint,1,opt,name=lobbyGroup,proto3" json:"lobbyGroup,omitempty"`
+	Filters      []*LobbyListFilter             `protobuf:"bytes,2,rep,name=filters,proto3" json:"filters,omitempty"`
+	HideFull     bool                           `protobuf:"varint,3,opt,name=hideFull,proto3" json:"hideFull,omitempty"`
+	HideLocked   bool                           `protobuf:"varint,4,opt,name=hideLocked,proto3" json:"hideLocked,omitempty"` // hide the lobbies with password or not joinable
+	NameContains string                         `protobuf:"bytes,5,opt,name=nameContains,proto3" json:"nameContains,omitempty"`
+	Sort         RequestLobbyList_LobbyListSort `protobuf:"varint,6,opt,name=sort,proto3,enum=Paper.RequestLobbyList_LobbyListSort" json:"sort,omitempty"`
+	PageSize     uint32                         `protobuf:"varint,7,opt,name=pageSize,proto3" json:"pageSize,omitempty"` // 0 means no limit
+	Cursor       string                         `protobuf:"bytes,8,opt,name=cursor,proto3" json:"cursor,omitempty"`      // the nextCursor of the previous page
 }
 
 func (x *RequestLobbyList) Reset() {
@@ -701,20 +1341,67 @@ func (x *RequestLobbyList) GetLobbyGroup() int32 {
 	return 0
 }
 
-type RequestLogin struct {
+func (x *RequestLobbyList) GetFilters() []*LobbyListFilter {
+	if x != nil {
+		return x.Filters
+	}
+	return nil
+}
+
+func (x *RequestLobbyList) GetHideFull() bool {
+	if x != nil {
+		return x.HideFull
+	}
+	return false
+}
+
+func (x *RequestLobbyList) GetHideLocked() bool {
+	if x != nil {
+		return x.HideLocked
+	}
+	return false
+}
+
+func (x *RequestLobbyList) GetNameContains() string {
+	if x != nil {
+		return x.NameContains
+	}
+	return ""
+}
+
+func (x *RequestLobbyList) GetSort() RequestLobbyList_LobbyListSort {
+	if x != nil {
+		return x.Sort
+	}
+	return RequestLobbyList_ById
+}
+
+func (x *RequestLobbyList) GetPageSize() uint32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *RequestLobbyList) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+// after subscribing, the server pushes ResponseLobbyListDelta of the lobby group
+type RequestLobbyListSubscribe struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ProtocolVer  uint64            `protobuf:"varint,1,opt,name=protocolVer,proto3" json:"protocolVer,omitempty"`
-	SteamID      uint64            `protobuf:"varint,2,opt,name=steamID,proto3" json:"steamID,omitempty"`
-	Name         string            `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
-	LangCode     RequestLogin_Lang `protobuf:"varint,4,opt,name=langCode,proto3,enum=Paper.RequestLogin_Lang" json:"langCode,omitempty"`
-	GameImageCrc uint32            `protobuf:"varint,5,opt,name=gameImageCrc,proto3" json:"gameImageCrc,omitempty"`
+	Subscribe  bool  `protobuf:"varint,1,opt,name=subscribe,proto3" json:"subscribe,omitempty"`
+	LobbyGroup int32 `protobuf:"varint,2,opt,name=lobbyGroup,proto3" json:"lobbyGroup,omitempty"`
 }
 
-func (x *RequestLogin) Reset() {
-	*x = RequestLogin{}
+func (x *RequestLobbyListSubscribe) Reset() {
+	*x = RequestLobbyListSubscribe{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_message_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -722,13 +1409,13 @@ func (x *RequestLogin) Reset() {
 	}
 }
 
-func (x *RequestLogin) String() string {
+func (x *RequestLobbyListSubscribe) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RequestLogin) ProtoMessage() {}
+func (*RequestLobbyListSubscribe) ProtoMessage() {}
 
-func (x *RequestLogin) ProtoReflect() protoreflect.Message {
+func (x *RequestLobbyListSubscribe) ProtoReflect() protoreflect.Message {
 	mi := &file_message_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -740,58 +1427,41 @@ func (x *RequestLogin) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RequestLogin.ProtoReflect.Descriptor instead.
-func (*RequestLogin) Descriptor() ([]byte, []int) {
+// Deprecated: Use RequestLobbyListSubscribe.ProtoReflect.Descriptor instead.
+func (*RequestLobbyListSubscribe) Descriptor() ([]byte, []int) {
 	return file_message_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *RequestLogin) GetProtocolVer() uint64 {
-	if x != nil {
-		return x.ProtocolVer
-	}
-	return 0
-}
-
-func (x *RequestLogin) GetSteamID() uint64 {
-	if x != nil {
-		return x.SteamID
-	}
-	return 0
-}
-
-func (x *RequestLogin) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
-}
-
-func (x *RequestLogin) GetLangCode() RequestLogin_Lang {
+func (x *RequestLobbyListSubscribe) GetSubscribe() bool {
 	if x != nil {
-		return x.LangCode
+		return x.Subscribe
 	}
-	return RequestLogin_EN
+	return false
 }
 
-func (x *RequestLogin) GetGameImageCrc() uint32 {
+func (x *RequestLobbyListSubscribe) GetLobbyGroup() int32 {
 	if x != nil {
-		return x.GameImageCrc
+		return x.LobbyGroup
 	}
 	return 0
 }
 
-type RequestLobbyCreate struct {
+// put the user into the quick-match queue, the server pushes ResponseMatchmakeStatus
+type RequestMatchmake struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name      string  `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Password  *string `protobuf:"bytes,2,opt,name=password,proto3,oneof" json:"password,omitempty"`
-	EnableP2P bool    `protobuf:"varint,3,opt,name=enableP2P,proto3" json:"enableP2P,omitempty"`
+	Cancel        bool                   `protobuf:"varint,1,opt,name=cancel,proto3" json:"cancel,omitempty"`
+	LobbyGroup    int32                  `protobuf:"varint,2,opt,name=lobbyGroup,proto3" json:"lobbyGroup,omitempty"`
+	ModeKeys      []*LobbyDataUpdateItem `protobuf:"bytes,3,rep,name=modeKeys,proto3" json:"modeKeys,omitempty"`            // the lobby data must be the same
+	PreferredSize uint32                 `protobuf:"varint,4,opt,name=preferredSize,proto3" json:"preferredSize,omitempty"` // 0 means any size
+	Lang          RequestLogin_Lang      `protobuf:"varint,5,opt,name=lang,proto3,enum=Paper.RequestLogin_Lang" json:"lang,omitempty"`
+	AnyLang       bool                   `protobuf:"varint,6,opt,name=anyLang,proto3" json:"anyLang,omitempty"`
 }
 
-func (x *RequestLobbyCreate) Reset() {
-	*x = RequestLobbyCreate{}
+func (x *RequestMatchmake) Reset() {
+	*x = RequestMatchmake{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_message_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -799,13 +1469,13 @@ func (x *RequestLobbyCreate) Reset() {
 	}
 }
 
-func (x *RequestLobbyCreate) String() string {
+func (x *RequestMatchmake) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RequestLobbyCreate) ProtoMessage() {}
+func (*RequestMatchmake) ProtoMessage() {}
 
-func (x *RequestLobbyCreate) ProtoReflect() protoreflect.Message {
+func (x *RequestMatchmake) ProtoReflect() protoreflect.Message {
 	mi := &file_message_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -817,44 +1487,65 @@ func (x *RequestLobbyCreate) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RequestLobbyCreate.ProtoReflect.Descriptor instead.
-func (*RequestLobbyCreate) Descriptor() ([]byte, []int) {
+// Deprecated: Use RequestMatchmake.ProtoReflect.Descriptor instead.
+func (*RequestMatchmake) Descriptor() ([]byte, []int) {
 	return file_message_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *RequestLobbyCreate) GetName() string {
+func (x *RequestMatchmake) GetCancel() bool {
 	if x != nil {
-		return x.Name
+		return x.Cancel
 	}
-	return ""
+	return false
 }
 
-func (x *RequestLobbyCreate) GetPassword() string {
-	if x != nil && x.Password != nil {
-		return *x.Password
+func (x *RequestMatchmake) GetLobbyGroup() int32 {
+	if x != nil {
+		return x.LobbyGroup
 	}
-	return ""
+	return 0
 }
 
-func (x *RequestLobbyCreate) GetEnableP2P() bool {
+func (x *RequestMatchmake) GetModeKeys() []*LobbyDataUpdateItem {
 	if x != nil {
-		return x.EnableP2P
+		return x.ModeKeys
+	}
+	return nil
+}
+
+func (x *RequestMatchmake) GetPreferredSize() uint32 {
+	if x != nil {
+		return x.PreferredSize
+	}
+	return 0
+}
+
+func (x *RequestMatchmake) GetLang() RequestLogin_Lang {
+	if x != nil {
+		return x.Lang
+	}
+	return RequestLogin_EN
+}
+
+func (x *RequestMatchmake) GetAnyLang() bool {
+	if x != nil {
+		return x.AnyLang
 	}
 	return false
 }
 
-type RequestSetLobbyData struct {
+// only the owner can manage the invite code of the lobby
+type RequestLobbyInviteCode struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	LobbyID  uint64 `protobuf:"varint,1,opt,name=lobbyID,proto3" json:"lobbyID,omitempty"`
-	PchKey   string `protobuf:"bytes,2,opt,name=pchKey,proto3" json:"pchKey,omitempty"`
-	PchValue string `protobuf:"bytes,3,opt,name=pchValue,proto3" json:"pchValue,omitempty"`
+	LobbyId uint64                        `protobuf:"varint,1,opt,name=lobbyId,proto3" json:"lobbyId,omitempty"`
+	Action  RequestLobbyInviteCode_Action `protobuf:"varint,2,opt,name=action,proto3,enum=Paper.RequestLobbyInviteCode_Action" json:"action,omitempty"`
 }
 
-func (x *RequestSetLobbyData) Reset() {
-	*x = RequestSetLobbyData{}
+func (x *RequestLobbyInviteCode) Reset() {
+	*x = RequestLobbyInviteCode{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_message_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -862,13 +1553,13 @@ func (x *RequestSetLobbyData) Reset() {
 	}
 }
 
-func (x *RequestSetLobbyData) String() string {
+func (x *RequestLobbyInviteCode) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RequestSetLobbyData) ProtoMessage() {}
+func (*RequestLobbyInviteCode) ProtoMessage() {}
 
-func (x *RequestSetLobbyData) ProtoReflect() protoreflect.Message {
+func (x *RequestLobbyInviteCode) ProtoReflect() protoreflect.Message {
 	mi := &file_message_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -880,44 +1571,37 @@ func (x *RequestSetLobbyData) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RequestSetLobbyData.ProtoReflect.Descriptor instead.
-func (*RequestSetLobbyData) Descriptor() ([]byte, []int) {
+// Deprecated: Use RequestLobbyInviteCode.ProtoReflect.Descriptor instead.
+func (*RequestLobbyInviteCode) Descriptor() ([]byte, []int) {
 	return file_message_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *RequestSetLobbyData) GetLobbyID() uint64 {
+func (x *RequestLobbyInviteCode) GetLobbyId() uint64 {
 	if x != nil {
-		return x.LobbyID
+		return x.LobbyId
 	}
 	return 0
 }
 
-func (x *RequestSetLobbyData) GetPchKey() string {
-	if x != nil {
-		return x.PchKey
-	}
-	return ""
-}
-
-func (x *RequestSetLobbyData) GetPchValue() string {
+func (x *RequestLobbyInviteCode) GetAction() RequestLobbyInviteCode_Action {
 	if x != nil {
-		return x.PchValue
+		return x.Action
 	}
-	return ""
+	return RequestLobbyInviteCode_Get
 }
 
-type RequestSetLobbyMemberData struct {
+// the server answers with ResponseLobbyJoin
+type RequestJoinLobbyByCode struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	LobbyID  uint64 `protobuf:"varint,1,opt,name=lobbyID,proto3" json:"lobbyID,omitempty"`
-	PchKey   string `protobuf:"bytes,2,opt,name=pchKey,proto3" json:"pchKey,omitempty"`
-	PchValue string `protobuf:"bytes,3,opt,name=pchValue,proto3" json:"pchValue,omitempty"`
+	Code     string `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	Spectate bool   `protobuf:"varint,2,opt,name=spectate,proto3" json:"spectate,omitempty"`
 }
 
-func (x *RequestSetLobbyMemberData) Reset() {
-	*x = RequestSetLobbyMemberData{}
+func (x *RequestJoinLobbyByCode) Reset() {
+	*x = RequestJoinLobbyByCode{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_message_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -925,13 +1609,13 @@ func (x *RequestSetLobbyMemberData) Reset() {
 	}
 }
 
-func (x *RequestSetLobbyMemberData) String() string {
+func (x *RequestJoinLobbyByCode) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RequestSetLobbyMemberData) ProtoMessage() {}
+func (*RequestJoinLobbyByCode) ProtoMessage() {}
 
-func (x *RequestSetLobbyMemberData) ProtoReflect() protoreflect.Message {
+func (x *RequestJoinLobbyByCode) ProtoReflect() protoreflect.Message {
 	mi := &file_message_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -943,43 +1627,42 @@ func (x *RequestSetLobbyMemberData) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RequestSetLobbyMemberData.ProtoReflect.Descriptor instead.
-func (*RequestSetLobbyMemberData) Descriptor() ([]byte, []int) {
+// Deprecated: Use RequestJoinLobbyByCode.ProtoReflect.Descriptor instead.
+func (*RequestJoinLobbyByCode) Descriptor() ([]byte, []int) {
 	return file_message_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *RequestSetLobbyMemberData) GetLobbyID() uint64 {
-	if x != nil {
-		return x.LobbyID
-	}
-	return 0
-}
-
-func (x *RequestSetLobbyMemberData) GetPchKey() string {
+func (x *RequestJoinLobbyByCode) GetCode() string {
 	if x != nil {
-		return x.PchKey
+		return x.Code
 	}
 	return ""
 }
 
-func (x *RequestSetLobbyMemberData) GetPchValue() string {
+func (x *RequestJoinLobbyByCode) GetSpectate() bool {
 	if x != nil {
-		return x.PchValue
+		return x.Spectate
 	}
-	return ""
+	return false
 }
 
-type RequestJoinLobby struct {
+// change several keys of the lobby data or the member data of the user at once.
+// The keys are deleted before the new values are set. If any expectation is not met,
+// nothing is changed.
+type RequestUpdateLobbyData struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	LobbyID  uint64  `protobuf:"varint,1,opt,name=lobbyID,proto3" json:"lobbyID,omitempty"`
-	Password *string `protobuf:"bytes,2,opt,name=password,proto3,oneof" json:"password,omitempty"`
+	LobbyId    uint64                 `protobuf:"varint,1,opt,name=lobbyId,proto3" json:"lobbyId,omitempty"`
+	MemberData bool                   `protobuf:"varint,2,opt,name=memberData,proto3" json:"memberData,omitempty"`
+	Set        []*LobbyDataUpdateItem `protobuf:"bytes,3,rep,name=set,proto3" json:"set,omitempty"`
+	Delete     []string               `protobuf:"bytes,4,rep,name=delete,proto3" json:"delete,omitempty"`
+	Expect     []*LobbyDataExpect     `protobuf:"bytes,5,rep,name=expect,proto3" json:"expect,omitempty"`
 }
 
-func (x *RequestJoinLobby) Reset() {
-	*x = RequestJoinLobby{}
+func (x *RequestUpdateLobbyData) Reset() {
+	*x = RequestUpdateLobbyData{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_message_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -987,13 +1670,13 @@ func (x *RequestJoinLobby) Reset() {
 	}
 }
 
-func (x *RequestJoinLobby) String() string {
+func (x *RequestUpdateLobbyData) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RequestJoinLobby) ProtoMessage() {}
+func (*RequestUpdateLobbyData) ProtoMessage() {}
 
-func (x *RequestJoinLobby) ProtoReflect() protoreflect.Message {
+func (x *RequestUpdateLobbyData) ProtoReflect() protoreflect.Message {
 	mi := &file_message_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1005,38 +1688,58 @@ func (x *RequestJoinLobby) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RequestJoinLobby.ProtoReflect.Descriptor instead.
-func (*RequestJoinLobby) Descriptor() ([]byte, []int) {
+// Deprecated: Use RequestUpdateLobbyData.ProtoReflect.Descriptor instead.
+func (*RequestUpdateLobbyData) Descriptor() ([]byte, []int) {
 	return file_message_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *RequestJoinLobby) GetLobbyID() uint64 {
+func (x *RequestUpdateLobbyData) GetLobbyId() uint64 {
 	if x != nil {
-		return x.LobbyID
+		return x.LobbyId
 	}
 	return 0
 }
 
-func (x *RequestJoinLobby) GetPassword() string {
-	if x != nil && x.Password != nil {
-		return *x.Password
+func (x *RequestUpdateLobbyData) GetMemberData() bool {
+	if x != nil {
+		return x.MemberData
 	}
-	return ""
+	return false
 }
 
-type RequestSendP2PPackage struct {
+func (x *RequestUpdateLobbyData) GetSet() []*LobbyDataUpdateItem {
+	if x != nil {
+		return x.Set
+	}
+	return nil
+}
+
+func (x *RequestUpdateLobbyData) GetDelete() []string {
+	if x != nil {
+		return x.Delete
+	}
+	return nil
+}
+
+func (x *RequestUpdateLobbyData) GetExpect() []*LobbyDataExpect {
+	if x != nil {
+		return x.Expect
+	}
+	return nil
+}
+
+type LobbyDataExpect struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	SteamIDRemote     uint64                         `protobuf:"varint,1,opt,name=steamIDRemote,proto3" json:"steamIDRemote,omitempty"`
-	FollowingDataSize uint32                         `protobuf:"varint,2,opt,name=followingDataSize,proto3" json:"followingDataSize,omitempty"`
-	P2PSendType       RequestSendP2PPackage_EP2PSend `protobuf:"varint,3,opt,name=p2pSendType,proto3,enum=Paper.RequestSendP2PPackage_EP2PSend" json:"p2pSendType,omitempty"`
-	Channel           int32                          `protobuf:"varint,4,opt,name=channel,proto3" json:"channel,omitempty"`
+	K      string `protobuf:"bytes,1,opt,name=k,proto3" json:"k,omitempty"`
+	V      string `protobuf:"bytes,2,opt,name=v,proto3" json:"v,omitempty"`
+	Absent bool   `protobuf:"varint,3,opt,name=absent,proto3" json:"absent,omitempty"` // the key must not exist, v is ignored
 }
 
-func (x *RequestSendP2PPackage) Reset() {
-	*x = RequestSendP2PPackage{}
+func (x *LobbyDataExpect) Reset() {
+	*x = LobbyDataExpect{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_message_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1044,13 +1747,13 @@ func (x *RequestSendP2PPackage) Reset() {
 	}
 }
 
-func (x *RequestSendP2PPackage) String() string {
+func (x *LobbyDataExpect) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RequestSendP2PPackage) ProtoMessage() {}
+func (*LobbyDataExpect) ProtoMessage() {}
 
-func (x *RequestSendP2PPackage) ProtoReflect() protoreflect.Message {
+func (x *LobbyDataExpect) ProtoReflect() protoreflect.Message {
 	mi := &file_message_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1062,49 +1765,45 @@ func (x *RequestSendP2PPackage) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RequestSendP2PPackage.ProtoReflect.Descriptor instead.
-func (*RequestSendP2PPackage) Descriptor() ([]byte, []int) {
+// Deprecated: Use LobbyDataExpect.ProtoReflect.Descriptor instead.
+func (*LobbyDataExpect) Descriptor() ([]byte, []int) {
 	return file_message_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *RequestSendP2PPackage) GetSteamIDRemote() uint64 {
-	if x != nil {
-		return x.SteamIDRemote
-	}
-	return 0
-}
-
-func (x *RequestSendP2PPackage) GetFollowingDataSize() uint32 {
+func (x *LobbyDataExpect) GetK() string {
 	if x != nil {
-		return x.FollowingDataSize
+		return x.K
 	}
-	return 0
+	return ""
 }
 
-func (x *RequestSendP2PPackage) GetP2PSendType() RequestSendP2PPackage_EP2PSend {
+func (x *LobbyDataExpect) GetV() string {
 	if x != nil {
-		return x.P2PSendType
+		return x.V
 	}
-	return RequestSendP2PPackage_EP2PSendUnreliable
+	return ""
 }
 
-func (x *RequestSendP2PPackage) GetChannel() int32 {
+func (x *LobbyDataExpect) GetAbsent() bool {
 	if x != nil {
-		return x.Channel
+		return x.Absent
 	}
-	return 0
+	return false
 }
 
-type RequestLeaveLobby struct {
+// the owner accepts or denies a ResponseLobbyJoinRequest
+type RequestLobbyAnswerJoin struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	LobbyID uint64 `protobuf:"varint,1,opt,name=lobbyID,proto3" json:"lobbyID,omitempty"`
+	LobbyId uint64 `protobuf:"varint,1,opt,name=lobbyId,proto3" json:"lobbyId,omitempty"`
+	SteamId uint64 `protobuf:"varint,2,opt,name=steamId,proto3" json:"steamId,omitempty"`
+	Accept  bool   `protobuf:"varint,3,opt,name=accept,proto3" json:"accept,omitempty"`
 }
 
-func (x *RequestLeaveLobby) Reset() {
-	*x = RequestLeaveLobby{}
+func (x *RequestLobbyAnswerJoin) Reset() {
+	*x = RequestLobbyAnswerJoin{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_message_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1112,13 +1811,13 @@ func (x *RequestLeaveLobby) Reset() {
 	}
 }
 
-func (x *RequestLeaveLobby) String() string {
+func (x *RequestLobbyAnswerJoin) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RequestLeaveLobby) ProtoMessage() {}
+func (*RequestLobbyAnswerJoin) ProtoMessage() {}
 
-func (x *RequestLeaveLobby) ProtoReflect() protoreflect.Message {
+func (x *RequestLobbyAnswerJoin) ProtoReflect() protoreflect.Message {
 	mi := &file_message_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1130,28 +1829,45 @@ func (x *RequestLeaveLobby) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RequestLeaveLobby.ProtoReflect.Descriptor instead.
-func (*RequestLeaveLobby) Descriptor() ([]byte, []int) {
+// Deprecated: Use RequestLobbyAnswerJoin.ProtoReflect.Descriptor instead.
+func (*RequestLobbyAnswerJoin) Descriptor() ([]byte, []int) {
 	return file_message_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *RequestLeaveLobby) GetLobbyID() uint64 {
+func (x *RequestLobbyAnswerJoin) GetLobbyId() uint64 {
 	if x != nil {
-		return x.LobbyID
+		return x.LobbyId
 	}
 	return 0
 }
 
-type RequestLogConsoleChat struct {
+func (x *RequestLobbyAnswerJoin) GetSteamId() uint64 {
+	if x != nil {
+		return x.SteamId
+	}
+	return 0
+}
+
+func (x *RequestLobbyAnswerJoin) GetAccept() bool {
+	if x != nil {
+		return x.Accept
+	}
+	return false
+}
+
+// change or list the friend list and the block list, the server answers with ResponseRelationList
+type RequestRelation struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Message string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	Action  RequestRelation_Action `protobuf:"varint,1,opt,name=action,proto3,enum=Paper.RequestRelation_Action" json:"action,omitempty"`
+	Kind    RequestRelation_Kind   `protobuf:"varint,2,opt,name=kind,proto3,enum=Paper.RequestRelation_Kind" json:"kind,omitempty"`
+	SteamId uint64                 `protobuf:"varint,3,opt,name=steamId,proto3" json:"steamId,omitempty"`
 }
 
-func (x *RequestLogConsoleChat) Reset() {
-	*x = RequestLogConsoleChat{}
+func (x *RequestRelation) Reset() {
+	*x = RequestRelation{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_message_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1159,13 +1875,13 @@ func (x *RequestLogConsoleChat) Reset() {
 	}
 }
 
-func (x *RequestLogConsoleChat) String() string {
+func (x *RequestRelation) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RequestLogConsoleChat) ProtoMessage() {}
+func (*RequestRelation) ProtoMessage() {}
 
-func (x *RequestLogConsoleChat) ProtoReflect() protoreflect.Message {
+func (x *RequestRelation) ProtoReflect() protoreflect.Message {
 	mi := &file_message_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1177,30 +1893,43 @@ func (x *RequestLogConsoleChat) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RequestLogConsoleChat.ProtoReflect.Descriptor instead.
-func (*RequestLogConsoleChat) Descriptor() ([]byte, []int) {
+// Deprecated: Use RequestRelation.ProtoReflect.Descriptor instead.
+func (*RequestRelation) Descriptor() ([]byte, []int) {
 	return file_message_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *RequestLogConsoleChat) GetMessage() string {
+func (x *RequestRelation) GetAction() RequestRelation_Action {
 	if x != nil {
-		return x.Message
+		return x.Action
 	}
-	return ""
+	return RequestRelation_List
 }
 
-type ResponseHeader struct {
+func (x *RequestRelation) GetKind() RequestRelation_Kind {
+	if x != nil {
+		return x.Kind
+	}
+	return RequestRelation_Friend
+}
+
+func (x *RequestRelation) GetSteamId() uint64 {
+	if x != nil {
+		return x.SteamId
+	}
+	return 0
+}
+
+// ask what the players are doing, an empty list means the friend list. The server answers with ResponsePresence
+type RequestPresenceQuery struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Type      ResponseHeader_ResponseMessageType `protobuf:"varint,1,opt,name=type,proto3,enum=Paper.ResponseHeader_ResponseMessageType" json:"type,omitempty"`
-	Length    int32                              `protobuf:"varint,2,opt,name=length,proto3" json:"length,omitempty"`
-	HoldValue int32                              `protobuf:"varint,3,opt,name=holdValue,proto3" json:"holdValue,omitempty"`
+	SteamIds []uint64 `protobuf:"varint,1,rep,packed,name=steamIds,proto3" json:"steamIds,omitempty"`
 }
 
-func (x *ResponseHeader) Reset() {
-	*x = ResponseHeader{}
+func (x *RequestPresenceQuery) Reset() {
+	*x = RequestPresenceQuery{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_message_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1208,13 +1937,13 @@ func (x *ResponseHeader) Reset() {
 	}
 }
 
-func (x *ResponseHeader) String() string {
+func (x *RequestPresenceQuery) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ResponseHeader) ProtoMessage() {}
+func (*RequestPresenceQuery) ProtoMessage() {}
 
-func (x *ResponseHeader) ProtoReflect() protoreflect.Message {
+func (x *RequestPresenceQuery) ProtoReflect() protoreflect.Message {
 	mi := &file_message_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1226,42 +1955,29 @@ func (x *ResponseHeader) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ResponseHeader.ProtoReflect.Descriptor instead.
-func (*ResponseHeader) Descriptor() ([]byte, []int) {
+// Deprecated: Use RequestPresenceQuery.ProtoReflect.Descriptor instead.
+func (*RequestPresenceQuery) Descriptor() ([]byte, []int) {
 	return file_message_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *ResponseHeader) GetType() ResponseHeader_ResponseMessageType {
-	if x != nil {
-		return x.Type
-	}
-	return ResponseHeader_Time
-}
-
-func (x *ResponseHeader) GetLength() int32 {
-	if x != nil {
-		return x.Length
-	}
-	return 0
-}
-
-func (x *ResponseHeader) GetHoldValue() int32 {
+func (x *RequestPresenceQuery) GetSteamIds() []uint64 {
 	if x != nil {
-		return x.HoldValue
+		return x.SteamIds
 	}
-	return 0
+	return nil
 }
 
-type ResponseLogConsoleChatFastMessage struct {
+// the presence changes of the friends are pushed with ResponsePresenceUpdate after the subscription
+type RequestPresenceSubscribe struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Msgs []string `protobuf:"bytes,1,rep,name=msgs,proto3" json:"msgs,omitempty"`
+	Subscribe bool `protobuf:"varint,1,opt,name=subscribe,proto3" json:"subscribe,omitempty"`
 }
 
-func (x *ResponseLogConsoleChatFastMessage) Reset() {
-	*x = ResponseLogConsoleChatFastMessage{}
+func (x *RequestPresenceSubscribe) Reset() {
+	*x = RequestPresenceSubscribe{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_message_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1269,13 +1985,13 @@ func (x *ResponseLogConsoleChatFastMessage) Reset() {
 	}
 }
 
-func (x *ResponseLogConsoleChatFastMessage) String() string {
+func (x *RequestPresenceSubscribe) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ResponseLogConsoleChatFastMessage) ProtoMessage() {}
+func (*RequestPresenceSubscribe) ProtoMessage() {}
 
-func (x *ResponseLogConsoleChatFastMessage) ProtoReflect() protoreflect.Message {
+func (x *RequestPresenceSubscribe) ProtoReflect() protoreflect.Message {
 	mi := &file_message_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1287,28 +2003,31 @@ func (x *ResponseLogConsoleChatFastMessage) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ResponseLogConsoleChatFastMessage.ProtoReflect.Descriptor instead.
-func (*ResponseLogConsoleChatFastMessage) Descriptor() ([]byte, []int) {
+// Deprecated: Use RequestPresenceSubscribe.ProtoReflect.Descriptor instead.
+func (*RequestPresenceSubscribe) Descriptor() ([]byte, []int) {
 	return file_message_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *ResponseLogConsoleChatFastMessage) GetMsgs() []string {
+func (x *RequestPresenceSubscribe) GetSubscribe() bool {
 	if x != nil {
-		return x.Msgs
+		return x.Subscribe
 	}
-	return nil
+	return false
 }
 
-type ResponseCreateRoomNameLists struct {
+// join the lobby of a friend, the server answers with ResponseLobbyJoin like RequestJoinLobby
+type RequestJoinPlayer struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Names []string `protobuf:"bytes,1,rep,name=names,proto3" json:"names,omitempty"`
+	SteamId  uint64  `protobuf:"varint,1,opt,name=steamId,proto3" json:"steamId,omitempty"`
+	Password *string `protobuf:"bytes,2,opt,name=password,proto3,oneof" json:"password,omitempty"`
+	Spectate bool    `protobuf:"varint,3,opt,name=spectate,proto3" json:"spectate,omitempty"`
 }
 
-func (x *ResponseCreateRoomNameLists) Reset() {
-	*x = ResponseCreateRoomNameLists{}
+func (x *RequestJoinPlayer) Reset() {
+	*x = RequestJoinPlayer{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_message_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1316,13 +2035,13 @@ func (x *ResponseCreateRoomNameLists) Reset() {
 	}
 }
 
-func (x *ResponseCreateRoomNameLists) String() string {
+func (x *RequestJoinPlayer) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ResponseCreateRoomNameLists) ProtoMessage() {}
+func (*RequestJoinPlayer) ProtoMessage() {}
 
-func (x *ResponseCreateRoomNameLists) ProtoReflect() protoreflect.Message {
+func (x *RequestJoinPlayer) ProtoReflect() protoreflect.Message {
 	mi := &file_message_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1334,28 +2053,46 @@ func (x *ResponseCreateRoomNameLists) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ResponseCreateRoomNameLists.ProtoReflect.Descriptor instead.
-func (*ResponseCreateRoomNameLists) Descriptor() ([]byte, []int) {
+// Deprecated: Use RequestJoinPlayer.ProtoReflect.Descriptor instead.
+func (*RequestJoinPlayer) Descriptor() ([]byte, []int) {
 	return file_message_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *ResponseCreateRoomNameLists) GetNames() []string {
+func (x *RequestJoinPlayer) GetSteamId() uint64 {
 	if x != nil {
-		return x.Names
+		return x.SteamId
 	}
-	return nil
+	return 0
 }
 
-type ResponseTime struct {
+func (x *RequestJoinPlayer) GetPassword() string {
+	if x != nil && x.Password != nil {
+		return *x.Password
+	}
+	return ""
+}
+
+func (x *RequestJoinPlayer) GetSpectate() bool {
+	if x != nil {
+		return x.Spectate
+	}
+	return false
+}
+
+// filter the lobbies by the lobby data, like the steam lobby list filters
+type LobbyListFilter struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Timestamp uint32 `protobuf:"varint,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Key        string                     `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Value      string                     `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	Comparison LobbyListFilter_Comparison `protobuf:"varint,3,opt,name=comparison,proto3,enum=Paper.LobbyListFilter_Comparison" json:"comparison,omitempty"`
+	Numeric    bool                       `protobuf:"varint,4,opt,name=numeric,proto3" json:"numeric,omitempty"` // compare the values as integers
 }
 
-func (x *ResponseTime) Reset() {
-	*x = ResponseTime{}
+func (x *LobbyListFilter) Reset() {
+	*x = LobbyListFilter{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_message_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1363,13 +2100,13 @@ func (x *ResponseTime) Reset() {
 	}
 }
 
-func (x *ResponseTime) String() string {
+func (x *LobbyListFilter) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ResponseTime) ProtoMessage() {}
+func (*LobbyListFilter) ProtoMessage() {}
 
-func (x *ResponseTime) ProtoReflect() protoreflect.Message {
+func (x *LobbyListFilter) ProtoReflect() protoreflect.Message {
 	mi := &file_message_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1381,35 +2118,56 @@ func (x *ResponseTime) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ResponseTime.ProtoReflect.Descriptor instead.
-func (*ResponseTime) Descriptor() ([]byte, []int) {
+// Deprecated: Use LobbyListFilter.ProtoReflect.Descriptor instead.
+func (*LobbyListFilter) Descriptor() ([]byte, []int) {
 	return file_message_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *ResponseTime) GetTimestamp() uint32 {
+func (x *LobbyListFilter) GetKey() string {
 	if x != nil {
-		return x.Timestamp
+		return x.Key
 	}
-	return 0
+	return ""
 }
 
-type LobbyInfo struct {
+func (x *LobbyListFilter) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *LobbyListFilter) GetComparison() LobbyListFilter_Comparison {
+	if x != nil {
+		return x.Comparison
+	}
+	return LobbyListFilter_Equal
+}
+
+func (x *LobbyListFilter) GetNumeric() bool {
+	if x != nil {
+		return x.Numeric
+	}
+	return false
+}
+
+type RequestLogin struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	LobbyId     uint64                 `protobuf:"varint,1,opt,name=lobbyId,proto3" json:"lobbyId,omitempty"`
-	OwnerId     uint64                 `protobuf:"varint,2,opt,name=ownerId,proto3" json:"ownerId,omitempty"`
-	UserIds     []uint64               `protobuf:"varint,3,rep,packed,name=userIds,proto3" json:"userIds,omitempty"`
-	Datas       []*LobbyDataUpdateItem `protobuf:"bytes,4,rep,name=datas,proto3" json:"datas,omitempty"`
-	UsersDatas  []*SingleUserData      `protobuf:"bytes,5,rep,name=usersDatas,proto3" json:"usersDatas,omitempty"`
-	Name        string                 `protobuf:"bytes,6,opt,name=name,proto3" json:"name,omitempty"`
-	HasPassword bool                   `protobuf:"varint,7,opt,name=hasPassword,proto3" json:"hasPassword,omitempty"`
-	Password    *string                `protobuf:"bytes,8,opt,name=password,proto3,oneof" json:"password,omitempty"` // sometimes, the server tells the client password.
+	ProtocolVer  uint64            `protobuf:"varint,1,opt,name=protocolVer,proto3" json:"protocolVer,omitempty"`
+	SteamID      uint64            `protobuf:"varint,2,opt,name=steamID,proto3" json:"steamID,omitempty"`
+	Name         string            `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	LangCode     RequestLogin_Lang `protobuf:"varint,4,opt,name=langCode,proto3,enum=Paper.RequestLogin_Lang" json:"langCode,omitempty"`
+	GameImageCrc uint32            `protobuf:"varint,5,opt,name=gameImageCrc,proto3" json:"gameImageCrc,omitempty"`
+	// the biggest message body the client can receive in one frame, 0 means the legacy 4096 bytes limit
+	MaxMessageSize   uint32 `protobuf:"varint,6,opt,name=maxMessageSize,proto3" json:"maxMessageSize,omitempty"`
+	SupportFragments bool   `protobuf:"varint,7,opt,name=supportFragments,proto3" json:"supportFragments,omitempty"` // the client can join the fragments of a big response
 }
 
-func (x *LobbyInfo) Reset() {
-	*x = LobbyInfo{}
+func (x *RequestLogin) Reset() {
+	*x = RequestLogin{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_message_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1417,13 +2175,13 @@ func (x *LobbyInfo) Reset() {
 	}
 }
 
-func (x *LobbyInfo) String() string {
+func (x *RequestLogin) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LobbyInfo) ProtoMessage() {}
+func (*RequestLogin) ProtoMessage() {}
 
-func (x *LobbyInfo) ProtoReflect() protoreflect.Message {
+func (x *RequestLogin) ProtoReflect() protoreflect.Message {
 	mi := &file_message_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1435,78 +2193,77 @@ func (x *LobbyInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LobbyInfo.ProtoReflect.Descriptor instead.
-func (*LobbyInfo) Descriptor() ([]byte, []int) {
+// Deprecated: Use RequestLogin.ProtoReflect.Descriptor instead.
+func (*RequestLogin) Descriptor() ([]byte, []int) {
 	return file_message_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *LobbyInfo) GetLobbyId() uint64 {
+func (x *RequestLogin) GetProtocolVer() uint64 {
 	if x != nil {
-		return x.LobbyId
+		return x.ProtocolVer
 	}
 	return 0
 }
 
-func (x *LobbyInfo) GetOwnerId() uint64 {
+func (x *RequestLogin) GetSteamID() uint64 {
 	if x != nil {
-		return x.OwnerId
+		return x.SteamID
 	}
 	return 0
 }
 
-func (x *LobbyInfo) GetUserIds() []uint64 {
+func (x *RequestLogin) GetName() string {
 	if x != nil {
-		return x.UserIds
+		return x.Name
 	}
-	return nil
+	return ""
 }
 
-func (x *LobbyInfo) GetDatas() []*LobbyDataUpdateItem {
+func (x *RequestLogin) GetLangCode() RequestLogin_Lang {
 	if x != nil {
-		return x.Datas
+		return x.LangCode
 	}
-	return nil
+	return RequestLogin_EN
 }
 
-func (x *LobbyInfo) GetUsersDatas() []*SingleUserData {
+func (x *RequestLogin) GetGameImageCrc() uint32 {
 	if x != nil {
-		return x.UsersDatas
+		return x.GameImageCrc
 	}
-	return nil
+	return 0
 }
 
-func (x *LobbyInfo) GetName() string {
+func (x *RequestLogin) GetMaxMessageSize() uint32 {
 	if x != nil {
-		return x.Name
+		return x.MaxMessageSize
 	}
-	return ""
+	return 0
 }
 
-func (x *LobbyInfo) GetHasPassword() bool {
+func (x *RequestLogin) GetSupportFragments() bool {
 	if x != nil {
-		return x.HasPassword
+		return x.SupportFragments
 	}
 	return false
 }
 
-func (x *LobbyInfo) GetPassword() string {
-	if x != nil && x.Password != nil {
-		return *x.Password
-	}
-	return ""
-}
-
-type SingleUserDataItem struct {
+type RequestLobbyCreate struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	K string `protobuf:"bytes,1,opt,name=K,proto3" json:"K,omitempty"`
-	V string `protobuf:"bytes,2,opt,name=V,proto3" json:"V,omitempty"`
+	Name            string          `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Password        *string         `protobuf:"bytes,2,opt,name=password,proto3,oneof" json:"password,omitempty"`
+	EnableP2P       bool            `protobuf:"varint,3,opt,name=enableP2P,proto3" json:"enableP2P,omitempty"`
+	MaxMembers      int32           `protobuf:"varint,4,opt,name=maxMembers,proto3" json:"maxMembers,omitempty"` // 0 means the server default
+	LobbyGroup      int32           `protobuf:"varint,5,opt,name=lobbyGroup,proto3" json:"lobbyGroup,omitempty"`
+	Visibility      LobbyVisibility `protobuf:"varint,6,opt,name=visibility,proto3,enum=Paper.LobbyVisibility" json:"visibility,omitempty"`
+	RequireApproval bool            `protobuf:"varint,7,opt,name=requireApproval,proto3" json:"requireApproval,omitempty"` // the owner must accept the users who want to join
+	Template        string          `protobuf:"bytes,8,opt,name=template,proto3" json:"template,omitempty"`                // the key of a lobby template defined by the admin, it decides the group, the size, p2p, the visibility and the lobby data
 }
 
-func (x *SingleUserDataItem) Reset() {
-	*x = SingleUserDataItem{}
+func (x *RequestLobbyCreate) Reset() {
+	*x = RequestLobbyCreate{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_message_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1514,13 +2271,13 @@ func (x *SingleUserDataItem) Reset() {
 	}
 }
 
-func (x *SingleUserDataItem) String() string {
+func (x *RequestLobbyCreate) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SingleUserDataItem) ProtoMessage() {}
+func (*RequestLobbyCreate) ProtoMessage() {}
 
-func (x *SingleUserDataItem) ProtoReflect() protoreflect.Message {
+func (x *RequestLobbyCreate) ProtoReflect() protoreflect.Message {
 	mi := &file_message_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1532,100 +2289,142 @@ func (x *SingleUserDataItem) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SingleUserDataItem.ProtoReflect.Descriptor instead.
-func (*SingleUserDataItem) Descriptor() ([]byte, []int) {
+// Deprecated: Use RequestLobbyCreate.ProtoReflect.Descriptor instead.
+func (*RequestLobbyCreate) Descriptor() ([]byte, []int) {
 	return file_message_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *SingleUserDataItem) GetK() string {
+func (x *RequestLobbyCreate) GetName() string {
 	if x != nil {
-		return x.K
+		return x.Name
 	}
 	return ""
 }
 
-func (x *SingleUserDataItem) GetV() string {
-	if x != nil {
-		return x.V
+func (x *RequestLobbyCreate) GetPassword() string {
+	if x != nil && x.Password != nil {
+		return *x.Password
 	}
 	return ""
 }
 
-type SingleUserData struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Data      []*SingleUserDataItem `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
-	UdpIpAddr []byte                `protobuf:"bytes,2,opt,name=udpIpAddr,proto3" json:"udpIpAddr,omitempty"`
-	UdpPort   int32                 `protobuf:"varint,3,opt,name=udpPort,proto3" json:"udpPort,omitempty"`
+func (x *RequestLobbyCreate) GetEnableP2P() bool {
+	if x != nil {
+		return x.EnableP2P
+	}
+	return false
 }
 
-func (x *SingleUserData) Reset() {
-	*x = SingleUserData{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_message_proto_msgTypes[17]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *RequestLobbyCreate) GetMaxMembers() int32 {
+	if x != nil {
+		return x.MaxMembers
 	}
+	return 0
 }
 
-func (x *SingleUserData) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *RequestLobbyCreate) GetLobbyGroup() int32 {
+	if x != nil {
+		return x.LobbyGroup
+	}
+	return 0
 }
 
-func (*SingleUserData) ProtoMessage() {}
-
-func (x *SingleUserData) ProtoReflect() protoreflect.Message {
-	mi := &file_message_proto_msgTypes[17]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *RequestLobbyCreate) GetVisibility() LobbyVisibility {
+	if x != nil {
+		return x.Visibility
 	}
-	return mi.MessageOf(x)
+	return LobbyVisibility_Public
 }
 
-// Deprecated: Use SingleUserData.ProtoReflect.Descriptor instead.
-func (*SingleUserData) Descriptor() ([]byte, []int) {
+func (x *RequestLobbyCreate) GetRequireApproval() bool {
+	if x != nil {
+		return x.RequireApproval
+	}
+	return false
+}
+
+func (x *RequestLobbyCreate) GetTemplate() string {
+	if x != nil {
+		return x.Template
+	}
+	return ""
+}
+
+type RequestSetLobbyData struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LobbyID  uint64 `protobuf:"varint,1,opt,name=lobbyID,proto3" json:"lobbyID,omitempty"`
+	PchKey   string `protobuf:"bytes,2,opt,name=pchKey,proto3" json:"pchKey,omitempty"`
+	PchValue string `protobuf:"bytes,3,opt,name=pchValue,proto3" json:"pchValue,omitempty"`
+}
+
+func (x *RequestSetLobbyData) Reset() {
+	*x = RequestSetLobbyData{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_message_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RequestSetLobbyData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestSetLobbyData) ProtoMessage() {}
+
+func (x *RequestSetLobbyData) ProtoReflect() protoreflect.Message {
+	mi := &file_message_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestSetLobbyData.ProtoReflect.Descriptor instead.
+func (*RequestSetLobbyData) Descriptor() ([]byte, []int) {
 	return file_message_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *SingleUserData) GetData() []*SingleUserDataItem {
+func (x *RequestSetLobbyData) GetLobbyID() uint64 {
 	if x != nil {
-		return x.Data
+		return x.LobbyID
 	}
-	return nil
+	return 0
 }
 
-func (x *SingleUserData) GetUdpIpAddr() []byte {
+func (x *RequestSetLobbyData) GetPchKey() string {
 	if x != nil {
-		return x.UdpIpAddr
+		return x.PchKey
 	}
-	return nil
+	return ""
 }
 
-func (x *SingleUserData) GetUdpPort() int32 {
+func (x *RequestSetLobbyData) GetPchValue() string {
 	if x != nil {
-		return x.UdpPort
+		return x.PchValue
 	}
-	return 0
+	return ""
 }
 
-type ResponseUserAddr struct {
+type RequestSetLobbyMemberData struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Lobbypos  int32  `protobuf:"varint,1,opt,name=lobbypos,proto3" json:"lobbypos,omitempty"`
-	UdpIpAddr []byte `protobuf:"bytes,2,opt,name=udpIpAddr,proto3" json:"udpIpAddr,omitempty"`
-	UdpPort   int32  `protobuf:"varint,3,opt,name=udpPort,proto3" json:"udpPort,omitempty"`
+	LobbyID  uint64 `protobuf:"varint,1,opt,name=lobbyID,proto3" json:"lobbyID,omitempty"`
+	PchKey   string `protobuf:"bytes,2,opt,name=pchKey,proto3" json:"pchKey,omitempty"`
+	PchValue string `protobuf:"bytes,3,opt,name=pchValue,proto3" json:"pchValue,omitempty"`
 }
 
-func (x *ResponseUserAddr) Reset() {
-	*x = ResponseUserAddr{}
+func (x *RequestSetLobbyMemberData) Reset() {
+	*x = RequestSetLobbyMemberData{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_message_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1633,13 +2432,13 @@ func (x *ResponseUserAddr) Reset() {
 	}
 }
 
-func (x *ResponseUserAddr) String() string {
+func (x *RequestSetLobbyMemberData) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ResponseUserAddr) ProtoMessage() {}
+func (*RequestSetLobbyMemberData) ProtoMessage() {}
 
-func (x *ResponseUserAddr) ProtoReflect() protoreflect.Message {
+func (x *RequestSetLobbyMemberData) ProtoReflect() protoreflect.Message {
 	mi := &file_message_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1651,42 +2450,44 @@ func (x *ResponseUserAddr) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ResponseUserAddr.ProtoReflect.Descriptor instead.
-func (*ResponseUserAddr) Descriptor() ([]byte, []int) {
+// Deprecated: Use RequestSetLobbyMemberData.ProtoReflect.Descriptor instead.
+func (*RequestSetLobbyMemberData) Descriptor() ([]byte, []int) {
 	return file_message_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *ResponseUserAddr) GetLobbypos() int32 {
+func (x *RequestSetLobbyMemberData) GetLobbyID() uint64 {
 	if x != nil {
-		return x.Lobbypos
+		return x.LobbyID
 	}
 	return 0
 }
 
-func (x *ResponseUserAddr) GetUdpIpAddr() []byte {
+func (x *RequestSetLobbyMemberData) GetPchKey() string {
 	if x != nil {
-		return x.UdpIpAddr
+		return x.PchKey
 	}
-	return nil
+	return ""
 }
 
-func (x *ResponseUserAddr) GetUdpPort() int32 {
+func (x *RequestSetLobbyMemberData) GetPchValue() string {
 	if x != nil {
-		return x.UdpPort
+		return x.PchValue
 	}
-	return 0
+	return ""
 }
 
-type ResponseLobbyList struct {
+type RequestJoinLobby struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Lobbies []*LobbyInfo `protobuf:"bytes,1,rep,name=lobbies,proto3" json:"lobbies,omitempty"`
+	LobbyID  uint64  `protobuf:"varint,1,opt,name=lobbyID,proto3" json:"lobbyID,omitempty"`
+	Password *string `protobuf:"bytes,2,opt,name=password,proto3,oneof" json:"password,omitempty"`
+	Spectate bool    `protobuf:"varint,3,opt,name=spectate,proto3" json:"spectate,omitempty"` // join without taking a player position
 }
 
-func (x *ResponseLobbyList) Reset() {
-	*x = ResponseLobbyList{}
+func (x *RequestJoinLobby) Reset() {
+	*x = RequestJoinLobby{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_message_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1694,13 +2495,13 @@ func (x *ResponseLobbyList) Reset() {
 	}
 }
 
-func (x *ResponseLobbyList) String() string {
+func (x *RequestJoinLobby) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ResponseLobbyList) ProtoMessage() {}
+func (*RequestJoinLobby) ProtoMessage() {}
 
-func (x *ResponseLobbyList) ProtoReflect() protoreflect.Message {
+func (x *RequestJoinLobby) ProtoReflect() protoreflect.Message {
 	mi := &file_message_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1712,29 +2513,45 @@ func (x *ResponseLobbyList) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ResponseLobbyList.ProtoReflect.Descriptor instead.
-func (*ResponseLobbyList) Descriptor() ([]byte, []int) {
+// Deprecated: Use RequestJoinLobby.ProtoReflect.Descriptor instead.
+func (*RequestJoinLobby) Descriptor() ([]byte, []int) {
 	return file_message_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *ResponseLobbyList) GetLobbies() []*LobbyInfo {
+func (x *RequestJoinLobby) GetLobbyID() uint64 {
 	if x != nil {
-		return x.Lobbies
+		return x.LobbyID
 	}
-	return nil
+	return 0
 }
 
-type ResponseLobbyCreated struct {
+func (x *RequestJoinLobby) GetPassword() string {
+	if x != nil && x.Password != nil {
+		return *x.Password
+	}
+	return ""
+}
+
+func (x *RequestJoinLobby) GetSpectate() bool {
+	if x != nil {
+		return x.Spectate
+	}
+	return false
+}
+
+type RequestSendP2PPackage struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	LobbyId uint64     `protobuf:"varint,1,opt,name=lobbyId,proto3" json:"lobbyId,omitempty"`
-	Info    *LobbyInfo `protobuf:"bytes,2,opt,name=info,proto3" json:"info,omitempty"`
+	SteamIDRemote     uint64                         `protobuf:"varint,1,opt,name=steamIDRemote,proto3" json:"steamIDRemote,omitempty"`
+	FollowingDataSize uint32                         `protobuf:"varint,2,opt,name=followingDataSize,proto3" json:"followingDataSize,omitempty"`
+	P2PSendType       RequestSendP2PPackage_EP2PSend `protobuf:"varint,3,opt,name=p2pSendType,proto3,enum=Paper.RequestSendP2PPackage_EP2PSend" json:"p2pSendType,omitempty"`
+	Channel           int32                          `protobuf:"varint,4,opt,name=channel,proto3" json:"channel,omitempty"`
 }
 
-func (x *ResponseLobbyCreated) Reset() {
-	*x = ResponseLobbyCreated{}
+func (x *RequestSendP2PPackage) Reset() {
+	*x = RequestSendP2PPackage{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_message_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1742,13 +2559,13 @@ func (x *ResponseLobbyCreated) Reset() {
 	}
 }
 
-func (x *ResponseLobbyCreated) String() string {
+func (x *RequestSendP2PPackage) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ResponseLobbyCreated) ProtoMessage() {}
+func (*RequestSendP2PPackage) ProtoMessage() {}
 
-func (x *ResponseLobbyCreated) ProtoReflect() protoreflect.Message {
+func (x *RequestSendP2PPackage) ProtoReflect() protoreflect.Message {
 	mi := &file_message_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1760,36 +2577,49 @@ func (x *ResponseLobbyCreated) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ResponseLobbyCreated.ProtoReflect.Descriptor instead.
-func (*ResponseLobbyCreated) Descriptor() ([]byte, []int) {
+// Deprecated: Use RequestSendP2PPackage.ProtoReflect.Descriptor instead.
+func (*RequestSendP2PPackage) Descriptor() ([]byte, []int) {
 	return file_message_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *ResponseLobbyCreated) GetLobbyId() uint64 {
+func (x *RequestSendP2PPackage) GetSteamIDRemote() uint64 {
 	if x != nil {
-		return x.LobbyId
+		return x.SteamIDRemote
 	}
 	return 0
 }
 
-func (x *ResponseLobbyCreated) GetInfo() *LobbyInfo {
+func (x *RequestSendP2PPackage) GetFollowingDataSize() uint32 {
 	if x != nil {
-		return x.Info
+		return x.FollowingDataSize
 	}
-	return nil
+	return 0
 }
 
-type ResponseUpdateUserInfo struct {
+func (x *RequestSendP2PPackage) GetP2PSendType() RequestSendP2PPackage_EP2PSend {
+	if x != nil {
+		return x.P2PSendType
+	}
+	return RequestSendP2PPackage_EP2PSendUnreliable
+}
+
+func (x *RequestSendP2PPackage) GetChannel() int32 {
+	if x != nil {
+		return x.Channel
+	}
+	return 0
+}
+
+type RequestLeaveLobby struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserId uint64 `protobuf:"varint,1,opt,name=userId,proto3" json:"userId,omitempty"`
-	Name   string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	LobbyID uint64 `protobuf:"varint,1,opt,name=lobbyID,proto3" json:"lobbyID,omitempty"`
 }
 
-func (x *ResponseUpdateUserInfo) Reset() {
-	*x = ResponseUpdateUserInfo{}
+func (x *RequestLeaveLobby) Reset() {
+	*x = RequestLeaveLobby{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_message_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1797,13 +2627,13 @@ func (x *ResponseUpdateUserInfo) Reset() {
 	}
 }
 
-func (x *ResponseUpdateUserInfo) String() string {
+func (x *RequestLeaveLobby) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ResponseUpdateUserInfo) ProtoMessage() {}
+func (*RequestLeaveLobby) ProtoMessage() {}
 
-func (x *ResponseUpdateUserInfo) ProtoReflect() protoreflect.Message {
+func (x *RequestLeaveLobby) ProtoReflect() protoreflect.Message {
 	mi := &file_message_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1815,36 +2645,28 @@ func (x *ResponseUpdateUserInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ResponseUpdateUserInfo.ProtoReflect.Descriptor instead.
-func (*ResponseUpdateUserInfo) Descriptor() ([]byte, []int) {
+// Deprecated: Use RequestLeaveLobby.ProtoReflect.Descriptor instead.
+func (*RequestLeaveLobby) Descriptor() ([]byte, []int) {
 	return file_message_proto_rawDescGZIP(), []int{21}
 }
 
-func (x *ResponseUpdateUserInfo) GetUserId() uint64 {
+func (x *RequestLeaveLobby) GetLobbyID() uint64 {
 	if x != nil {
-		return x.UserId
+		return x.LobbyID
 	}
 	return 0
 }
 
-func (x *ResponseUpdateUserInfo) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
-}
-
-type LobbyDataUpdateItem struct {
+type RequestLogConsoleChat struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	K string `protobuf:"bytes,1,opt,name=k,proto3" json:"k,omitempty"`
-	V string `protobuf:"bytes,2,opt,name=v,proto3" json:"v,omitempty"`
+	Message string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
 }
 
-func (x *LobbyDataUpdateItem) Reset() {
-	*x = LobbyDataUpdateItem{}
+func (x *RequestLogConsoleChat) Reset() {
+	*x = RequestLogConsoleChat{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_message_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1852,13 +2674,13 @@ func (x *LobbyDataUpdateItem) Reset() {
 	}
 }
 
-func (x *LobbyDataUpdateItem) String() string {
+func (x *RequestLogConsoleChat) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LobbyDataUpdateItem) ProtoMessage() {}
+func (*RequestLogConsoleChat) ProtoMessage() {}
 
-func (x *LobbyDataUpdateItem) ProtoReflect() protoreflect.Message {
+func (x *RequestLogConsoleChat) ProtoReflect() protoreflect.Message {
 	mi := &file_message_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1870,38 +2692,28 @@ func (x *LobbyDataUpdateItem) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LobbyDataUpdateItem.ProtoReflect.Descriptor instead.
-func (*LobbyDataUpdateItem) Descriptor() ([]byte, []int) {
+// Deprecated: Use RequestLogConsoleChat.ProtoReflect.Descriptor instead.
+func (*RequestLogConsoleChat) Descriptor() ([]byte, []int) {
 	return file_message_proto_rawDescGZIP(), []int{22}
 }
 
-func (x *LobbyDataUpdateItem) GetK() string {
-	if x != nil {
-		return x.K
-	}
-	return ""
-}
-
-func (x *LobbyDataUpdateItem) GetV() string {
+func (x *RequestLogConsoleChat) GetMessage() string {
 	if x != nil {
-		return x.V
+		return x.Message
 	}
 	return ""
 }
 
-type ResponseLobbyDataUpdate struct {
+type RequestGlobalChatJoin struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	SteamIdLobby  uint64                 `protobuf:"varint,1,opt,name=steamIdLobby,proto3" json:"steamIdLobby,omitempty"`
-	SteamIdMember uint64                 `protobuf:"varint,2,opt,name=steamIdMember,proto3" json:"steamIdMember,omitempty"`
-	OnlyLobbyId   bool                   `protobuf:"varint,3,opt,name=onlyLobbyId,proto3" json:"onlyLobbyId,omitempty"`
-	Datas         []*LobbyDataUpdateItem `protobuf:"bytes,4,rep,name=datas,proto3" json:"datas,omitempty"`
+	Join bool `protobuf:"varint,1,opt,name=join,proto3" json:"join,omitempty"`
 }
 
-func (x *ResponseLobbyDataUpdate) Reset() {
-	*x = ResponseLobbyDataUpdate{}
+func (x *RequestGlobalChatJoin) Reset() {
+	*x = RequestGlobalChatJoin{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_message_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1909,13 +2721,13 @@ func (x *ResponseLobbyDataUpdate) Reset() {
 	}
 }
 
-func (x *ResponseLobbyDataUpdate) String() string {
+func (x *RequestGlobalChatJoin) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ResponseLobbyDataUpdate) ProtoMessage() {}
+func (*RequestGlobalChatJoin) ProtoMessage() {}
 
-func (x *ResponseLobbyDataUpdate) ProtoReflect() protoreflect.Message {
+func (x *RequestGlobalChatJoin) ProtoReflect() protoreflect.Message {
 	mi := &file_message_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1927,55 +2739,28 @@ func (x *ResponseLobbyDataUpdate) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ResponseLobbyDataUpdate.ProtoReflect.Descriptor instead.
-func (*ResponseLobbyDataUpdate) Descriptor() ([]byte, []int) {
+// Deprecated: Use RequestGlobalChatJoin.ProtoReflect.Descriptor instead.
+func (*RequestGlobalChatJoin) Descriptor() ([]byte, []int) {
 	return file_message_proto_rawDescGZIP(), []int{23}
 }
 
-func (x *ResponseLobbyDataUpdate) GetSteamIdLobby() uint64 {
-	if x != nil {
-		return x.SteamIdLobby
-	}
-	return 0
-}
-
-func (x *ResponseLobbyDataUpdate) GetSteamIdMember() uint64 {
-	if x != nil {
-		return x.SteamIdMember
-	}
-	return 0
-}
-
-func (x *ResponseLobbyDataUpdate) GetOnlyLobbyId() bool {
+func (x *RequestGlobalChatJoin) GetJoin() bool {
 	if x != nil {
-		return x.OnlyLobbyId
+		return x.Join
 	}
 	return false
 }
 
-func (x *ResponseLobbyDataUpdate) GetDatas() []*LobbyDataUpdateItem {
-	if x != nil {
-		return x.Datas
-	}
-	return nil
-}
-
-type ResponseLobbyChatUpdate struct {
+type RequestGlobalChat struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	SteamIdLobby               uint64                                        `protobuf:"varint,1,opt,name=steamIdLobby,proto3" json:"steamIdLobby,omitempty"`
-	SteamIdUserChanged         uint64                                        `protobuf:"varint,2,opt,name=steamIdUserChanged,proto3" json:"steamIdUserChanged,omitempty"`
-	SteamIdUserChangedIsLobby  bool                                          `protobuf:"varint,3,opt,name=steamIdUserChangedIsLobby,proto3" json:"steamIdUserChangedIsLobby,omitempty"`
-	SteamIdMakingChange        uint64                                        `protobuf:"varint,4,opt,name=steamIdMakingChange,proto3" json:"steamIdMakingChange,omitempty"`
-	SteamIdMakingChangeIsLobby bool                                          `protobuf:"varint,5,opt,name=steamIdMakingChangeIsLobby,proto3" json:"steamIdMakingChangeIsLobby,omitempty"`
-	ChatMemberStateChange      ResponseLobbyChatUpdate_ChatMemberStateChange `protobuf:"varint,6,opt,name=chatMemberStateChange,proto3,enum=Paper.ResponseLobbyChatUpdate_ChatMemberStateChange" json:"chatMemberStateChange,omitempty"`
-	LobbyInfo                  *LobbyInfo                                    `protobuf:"bytes,7,opt,name=lobbyInfo,proto3,oneof" json:"lobbyInfo,omitempty"`
+	Message string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
 }
 
-func (x *ResponseLobbyChatUpdate) Reset() {
-	*x = ResponseLobbyChatUpdate{}
+func (x *RequestGlobalChat) Reset() {
+	*x = RequestGlobalChat{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_message_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1983,13 +2768,13 @@ func (x *ResponseLobbyChatUpdate) Reset() {
 	}
 }
 
-func (x *ResponseLobbyChatUpdate) String() string {
+func (x *RequestGlobalChat) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ResponseLobbyChatUpdate) ProtoMessage() {}
+func (*RequestGlobalChat) ProtoMessage() {}
 
-func (x *ResponseLobbyChatUpdate) ProtoReflect() protoreflect.Message {
+func (x *RequestGlobalChat) ProtoReflect() protoreflect.Message {
 	mi := &file_message_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2001,74 +2786,29 @@ func (x *ResponseLobbyChatUpdate) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ResponseLobbyChatUpdate.ProtoReflect.Descriptor instead.
-func (*ResponseLobbyChatUpdate) Descriptor() ([]byte, []int) {
+// Deprecated: Use RequestGlobalChat.ProtoReflect.Descriptor instead.
+func (*RequestGlobalChat) Descriptor() ([]byte, []int) {
 	return file_message_proto_rawDescGZIP(), []int{24}
 }
 
-func (x *ResponseLobbyChatUpdate) GetSteamIdLobby() uint64 {
-	if x != nil {
-		return x.SteamIdLobby
-	}
-	return 0
-}
-
-func (x *ResponseLobbyChatUpdate) GetSteamIdUserChanged() uint64 {
-	if x != nil {
-		return x.SteamIdUserChanged
-	}
-	return 0
-}
-
-func (x *ResponseLobbyChatUpdate) GetSteamIdUserChangedIsLobby() bool {
-	if x != nil {
-		return x.SteamIdUserChangedIsLobby
-	}
-	return false
-}
-
-func (x *ResponseLobbyChatUpdate) GetSteamIdMakingChange() uint64 {
-	if x != nil {
-		return x.SteamIdMakingChange
-	}
-	return 0
-}
-
-func (x *ResponseLobbyChatUpdate) GetSteamIdMakingChangeIsLobby() bool {
-	if x != nil {
-		return x.SteamIdMakingChangeIsLobby
-	}
-	return false
-}
-
-func (x *ResponseLobbyChatUpdate) GetChatMemberStateChange() ResponseLobbyChatUpdate_ChatMemberStateChange {
-	if x != nil {
-		return x.ChatMemberStateChange
-	}
-	return ResponseLobbyChatUpdate_None
-}
-
-func (x *ResponseLobbyChatUpdate) GetLobbyInfo() *LobbyInfo {
+func (x *RequestGlobalChat) GetMessage() string {
 	if x != nil {
-		return x.LobbyInfo
+		return x.Message
 	}
-	return nil
+	return ""
 }
 
-type ResponseLobbyJoin struct {
+type RequestWhisper struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Locked                bool       `protobuf:"varint,1,opt,name=locked,proto3" json:"locked,omitempty"`
-	ChatRoomEnterResponse uint32     `protobuf:"varint,2,opt,name=ChatRoomEnterResponse,proto3" json:"ChatRoomEnterResponse,omitempty"`
-	ChatPermissions       uint32     `protobuf:"varint,3,opt,name=ChatPermissions,proto3" json:"ChatPermissions,omitempty"`
-	LobbyId               uint32     `protobuf:"varint,4,opt,name=lobbyId,proto3" json:"lobbyId,omitempty"`
-	Info                  *LobbyInfo `protobuf:"bytes,5,opt,name=info,proto3" json:"info,omitempty"`
+	SteamIdTarget uint64 `protobuf:"varint,1,opt,name=steamIdTarget,proto3" json:"steamIdTarget,omitempty"`
+	Message       string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
 }
 
-func (x *ResponseLobbyJoin) Reset() {
-	*x = ResponseLobbyJoin{}
+func (x *RequestWhisper) Reset() {
+	*x = RequestWhisper{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_message_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2076,13 +2816,13 @@ func (x *ResponseLobbyJoin) Reset() {
 	}
 }
 
-func (x *ResponseLobbyJoin) String() string {
+func (x *RequestWhisper) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ResponseLobbyJoin) ProtoMessage() {}
+func (*RequestWhisper) ProtoMessage() {}
 
-func (x *ResponseLobbyJoin) ProtoReflect() protoreflect.Message {
+func (x *RequestWhisper) ProtoReflect() protoreflect.Message {
 	mi := &file_message_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2094,58 +2834,37 @@ func (x *ResponseLobbyJoin) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ResponseLobbyJoin.ProtoReflect.Descriptor instead.
-func (*ResponseLobbyJoin) Descriptor() ([]byte, []int) {
+// Deprecated: Use RequestWhisper.ProtoReflect.Descriptor instead.
+func (*RequestWhisper) Descriptor() ([]byte, []int) {
 	return file_message_proto_rawDescGZIP(), []int{25}
 }
 
-func (x *ResponseLobbyJoin) GetLocked() bool {
-	if x != nil {
-		return x.Locked
-	}
-	return false
-}
-
-func (x *ResponseLobbyJoin) GetChatRoomEnterResponse() uint32 {
-	if x != nil {
-		return x.ChatRoomEnterResponse
-	}
-	return 0
-}
-
-func (x *ResponseLobbyJoin) GetChatPermissions() uint32 {
-	if x != nil {
-		return x.ChatPermissions
-	}
-	return 0
-}
-
-func (x *ResponseLobbyJoin) GetLobbyId() uint32 {
+func (x *RequestWhisper) GetSteamIdTarget() uint64 {
 	if x != nil {
-		return x.LobbyId
+		return x.SteamIdTarget
 	}
 	return 0
 }
 
-func (x *ResponseLobbyJoin) GetInfo() *LobbyInfo {
+func (x *RequestWhisper) GetMessage() string {
 	if x != nil {
-		return x.Info
+		return x.Message
 	}
-	return nil
+	return ""
 }
 
-type ResponseHasNewP2PPackage struct {
+// the lobby owner requests below are ignored if the user is not the owner
+type RequestLobbyKick struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	SteamIDSource uint64 `protobuf:"varint,1,opt,name=steamIDSource,proto3" json:"steamIDSource,omitempty"`
-	DataSize      uint32 `protobuf:"varint,2,opt,name=dataSize,proto3" json:"dataSize,omitempty"`
-	Channel       int32  `protobuf:"varint,3,opt,name=channel,proto3" json:"channel,omitempty"`
+	LobbyID       uint64 `protobuf:"varint,1,opt,name=lobbyID,proto3" json:"lobbyID,omitempty"`
+	SteamIdTarget uint64 `protobuf:"varint,2,opt,name=steamIdTarget,proto3" json:"steamIdTarget,omitempty"`
 }
 
-func (x *ResponseHasNewP2PPackage) Reset() {
-	*x = ResponseHasNewP2PPackage{}
+func (x *RequestLobbyKick) Reset() {
+	*x = RequestLobbyKick{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_message_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2153,13 +2872,13 @@ func (x *ResponseHasNewP2PPackage) Reset() {
 	}
 }
 
-func (x *ResponseHasNewP2PPackage) String() string {
+func (x *RequestLobbyKick) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ResponseHasNewP2PPackage) ProtoMessage() {}
+func (*RequestLobbyKick) ProtoMessage() {}
 
-func (x *ResponseHasNewP2PPackage) ProtoReflect() protoreflect.Message {
+func (x *RequestLobbyKick) ProtoReflect() protoreflect.Message {
 	mi := &file_message_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2171,42 +2890,37 @@ func (x *ResponseHasNewP2PPackage) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ResponseHasNewP2PPackage.ProtoReflect.Descriptor instead.
-func (*ResponseHasNewP2PPackage) Descriptor() ([]byte, []int) {
+// Deprecated: Use RequestLobbyKick.ProtoReflect.Descriptor instead.
+func (*RequestLobbyKick) Descriptor() ([]byte, []int) {
 	return file_message_proto_rawDescGZIP(), []int{26}
 }
 
-func (x *ResponseHasNewP2PPackage) GetSteamIDSource() uint64 {
-	if x != nil {
-		return x.SteamIDSource
-	}
-	return 0
-}
-
-func (x *ResponseHasNewP2PPackage) GetDataSize() uint32 {
+func (x *RequestLobbyKick) GetLobbyID() uint64 {
 	if x != nil {
-		return x.DataSize
+		return x.LobbyID
 	}
 	return 0
 }
 
-func (x *ResponseHasNewP2PPackage) GetChannel() int32 {
+func (x *RequestLobbyKick) GetSteamIdTarget() uint64 {
 	if x != nil {
-		return x.Channel
+		return x.SteamIdTarget
 	}
 	return 0
 }
 
-type ResponseP2PSessionArrive struct {
+type RequestLobbyBan struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	SteamIDSource uint64 `protobuf:"varint,1,opt,name=steamIDSource,proto3" json:"steamIDSource,omitempty"`
+	LobbyID       uint64 `protobuf:"varint,1,opt,name=lobbyID,proto3" json:"lobbyID,omitempty"`
+	SteamIdTarget uint64 `protobuf:"varint,2,opt,name=steamIdTarget,proto3" json:"steamIdTarget,omitempty"`
+	Unban         bool   `protobuf:"varint,3,opt,name=unban,proto3" json:"unban,omitempty"`
 }
 
-func (x *ResponseP2PSessionArrive) Reset() {
-	*x = ResponseP2PSessionArrive{}
+func (x *RequestLobbyBan) Reset() {
+	*x = RequestLobbyBan{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_message_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2214,13 +2928,13 @@ func (x *ResponseP2PSessionArrive) Reset() {
 	}
 }
 
-func (x *ResponseP2PSessionArrive) String() string {
+func (x *RequestLobbyBan) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ResponseP2PSessionArrive) ProtoMessage() {}
+func (*RequestLobbyBan) ProtoMessage() {}
 
-func (x *ResponseP2PSessionArrive) ProtoReflect() protoreflect.Message {
+func (x *RequestLobbyBan) ProtoReflect() protoreflect.Message {
 	mi := &file_message_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2232,30 +2946,43 @@ func (x *ResponseP2PSessionArrive) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ResponseP2PSessionArrive.ProtoReflect.Descriptor instead.
-func (*ResponseP2PSessionArrive) Descriptor() ([]byte, []int) {
+// Deprecated: Use RequestLobbyBan.ProtoReflect.Descriptor instead.
+func (*RequestLobbyBan) Descriptor() ([]byte, []int) {
 	return file_message_proto_rawDescGZIP(), []int{27}
 }
 
-func (x *ResponseP2PSessionArrive) GetSteamIDSource() uint64 {
+func (x *RequestLobbyBan) GetLobbyID() uint64 {
 	if x != nil {
-		return x.SteamIDSource
+		return x.LobbyID
 	}
 	return 0
 }
 
-type ResponseServerPublicMessage struct {
+func (x *RequestLobbyBan) GetSteamIdTarget() uint64 {
+	if x != nil {
+		return x.SteamIdTarget
+	}
+	return 0
+}
+
+func (x *RequestLobbyBan) GetUnban() bool {
+	if x != nil {
+		return x.Unban
+	}
+	return false
+}
+
+type RequestLobbySetPassword struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Type    ResponseServerPublicMessage_PublicMessageType `protobuf:"varint,1,opt,name=type,proto3,enum=Paper.ResponseServerPublicMessage_PublicMessageType" json:"type,omitempty"`
-	Str     *string                                       `protobuf:"bytes,2,opt,name=str,proto3,oneof" json:"str,omitempty"`
-	Caption *string                                       `protobuf:"bytes,3,opt,name=caption,proto3,oneof" json:"caption,omitempty"`
+	LobbyID  uint64  `protobuf:"varint,1,opt,name=lobbyID,proto3" json:"lobbyID,omitempty"`
+	Password *string `protobuf:"bytes,2,opt,name=password,proto3,oneof" json:"password,omitempty"` // clear the password if not set
 }
 
-func (x *ResponseServerPublicMessage) Reset() {
-	*x = ResponseServerPublicMessage{}
+func (x *RequestLobbySetPassword) Reset() {
+	*x = RequestLobbySetPassword{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_message_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2263,13 +2990,13 @@ func (x *ResponseServerPublicMessage) Reset() {
 	}
 }
 
-func (x *ResponseServerPublicMessage) String() string {
+func (x *RequestLobbySetPassword) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ResponseServerPublicMessage) ProtoMessage() {}
+func (*RequestLobbySetPassword) ProtoMessage() {}
 
-func (x *ResponseServerPublicMessage) ProtoReflect() protoreflect.Message {
+func (x *RequestLobbySetPassword) ProtoReflect() protoreflect.Message {
 	mi := &file_message_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2281,42 +3008,36 @@ func (x *ResponseServerPublicMessage) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ResponseServerPublicMessage.ProtoReflect.Descriptor instead.
-func (*ResponseServerPublicMessage) Descriptor() ([]byte, []int) {
+// Deprecated: Use RequestLobbySetPassword.ProtoReflect.Descriptor instead.
+func (*RequestLobbySetPassword) Descriptor() ([]byte, []int) {
 	return file_message_proto_rawDescGZIP(), []int{28}
 }
 
-func (x *ResponseServerPublicMessage) GetType() ResponseServerPublicMessage_PublicMessageType {
+func (x *RequestLobbySetPassword) GetLobbyID() uint64 {
 	if x != nil {
-		return x.Type
-	}
-	return ResponseServerPublicMessage_DisplayStringAndContinue
-}
-
-func (x *ResponseServerPublicMessage) GetStr() string {
-	if x != nil && x.Str != nil {
-		return *x.Str
+		return x.LobbyID
 	}
-	return ""
+	return 0
 }
 
-func (x *ResponseServerPublicMessage) GetCaption() string {
-	if x != nil && x.Caption != nil {
-		return *x.Caption
+func (x *RequestLobbySetPassword) GetPassword() string {
+	if x != nil && x.Password != nil {
+		return *x.Password
 	}
 	return ""
 }
 
-type ResponseServerUdpToken struct {
+type RequestLobbySetJoinable struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Token string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	LobbyID  uint64 `protobuf:"varint,1,opt,name=lobbyID,proto3" json:"lobbyID,omitempty"`
+	Joinable bool   `protobuf:"varint,2,opt,name=joinable,proto3" json:"joinable,omitempty"`
 }
 
-func (x *ResponseServerUdpToken) Reset() {
-	*x = ResponseServerUdpToken{}
+func (x *RequestLobbySetJoinable) Reset() {
+	*x = RequestLobbySetJoinable{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_message_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2324,13 +3045,13 @@ func (x *ResponseServerUdpToken) Reset() {
 	}
 }
 
-func (x *ResponseServerUdpToken) String() string {
+func (x *RequestLobbySetJoinable) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ResponseServerUdpToken) ProtoMessage() {}
+func (*RequestLobbySetJoinable) ProtoMessage() {}
 
-func (x *ResponseServerUdpToken) ProtoReflect() protoreflect.Message {
+func (x *RequestLobbySetJoinable) ProtoReflect() protoreflect.Message {
 	mi := &file_message_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2342,29 +3063,36 @@ func (x *ResponseServerUdpToken) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ResponseServerUdpToken.ProtoReflect.Descriptor instead.
-func (*ResponseServerUdpToken) Descriptor() ([]byte, []int) {
+// Deprecated: Use RequestLobbySetJoinable.ProtoReflect.Descriptor instead.
+func (*RequestLobbySetJoinable) Descriptor() ([]byte, []int) {
 	return file_message_proto_rawDescGZIP(), []int{29}
 }
 
-func (x *ResponseServerUdpToken) GetToken() string {
+func (x *RequestLobbySetJoinable) GetLobbyID() uint64 {
 	if x != nil {
-		return x.Token
+		return x.LobbyID
 	}
-	return ""
+	return 0
 }
 
-type ResponseLogConsoleChat struct {
+func (x *RequestLobbySetJoinable) GetJoinable() bool {
+	if x != nil {
+		return x.Joinable
+	}
+	return false
+}
+
+type RequestLobbyTransferOwner struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Steamid int64  `protobuf:"varint,1,opt,name=steamid,proto3" json:"steamid,omitempty"`
-	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	LobbyID         uint64 `protobuf:"varint,1,opt,name=lobbyID,proto3" json:"lobbyID,omitempty"`
+	SteamIdNewOwner uint64 `protobuf:"varint,2,opt,name=steamIdNewOwner,proto3" json:"steamIdNewOwner,omitempty"`
 }
 
-func (x *ResponseLogConsoleChat) Reset() {
-	*x = ResponseLogConsoleChat{}
+func (x *RequestLobbyTransferOwner) Reset() {
+	*x = RequestLobbyTransferOwner{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_message_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2372,13 +3100,13 @@ func (x *ResponseLogConsoleChat) Reset() {
 	}
 }
 
-func (x *ResponseLogConsoleChat) String() string {
+func (x *RequestLobbyTransferOwner) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ResponseLogConsoleChat) ProtoMessage() {}
+func (*RequestLobbyTransferOwner) ProtoMessage() {}
 
-func (x *ResponseLogConsoleChat) ProtoReflect() protoreflect.Message {
+func (x *RequestLobbyTransferOwner) ProtoReflect() protoreflect.Message {
 	mi := &file_message_proto_msgTypes[30]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2390,36 +3118,36 @@ func (x *ResponseLogConsoleChat) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ResponseLogConsoleChat.ProtoReflect.Descriptor instead.
-func (*ResponseLogConsoleChat) Descriptor() ([]byte, []int) {
+// Deprecated: Use RequestLobbyTransferOwner.ProtoReflect.Descriptor instead.
+func (*RequestLobbyTransferOwner) Descriptor() ([]byte, []int) {
 	return file_message_proto_rawDescGZIP(), []int{30}
 }
 
-func (x *ResponseLogConsoleChat) GetSteamid() int64 {
+func (x *RequestLobbyTransferOwner) GetLobbyID() uint64 {
 	if x != nil {
-		return x.Steamid
+		return x.LobbyID
 	}
 	return 0
 }
 
-func (x *ResponseLogConsoleChat) GetMessage() string {
+func (x *RequestLobbyTransferOwner) GetSteamIdNewOwner() uint64 {
 	if x != nil {
-		return x.Message
+		return x.SteamIdNewOwner
 	}
-	return ""
+	return 0
 }
 
-type UserInfo struct {
+type RequestLobbySetSpectators struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserId   int32 `protobuf:"varint,1,opt,name=userId,proto3" json:"userId,omitempty"`
-	UserName int32 `protobuf:"varint,2,opt,name=userName,proto3" json:"userName,omitempty"`
+	LobbyID uint64 `protobuf:"varint,1,opt,name=lobbyID,proto3" json:"lobbyID,omitempty"`
+	Allow   bool   `protobuf:"varint,2,opt,name=allow,proto3" json:"allow,omitempty"`
 }
 
-func (x *UserInfo) Reset() {
-	*x = UserInfo{}
+func (x *RequestLobbySetSpectators) Reset() {
+	*x = RequestLobbySetSpectators{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_message_proto_msgTypes[31]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2427,13 +3155,13 @@ func (x *UserInfo) Reset() {
 	}
 }
 
-func (x *UserInfo) String() string {
+func (x *RequestLobbySetSpectators) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UserInfo) ProtoMessage() {}
+func (*RequestLobbySetSpectators) ProtoMessage() {}
 
-func (x *UserInfo) ProtoReflect() protoreflect.Message {
+func (x *RequestLobbySetSpectators) ProtoReflect() protoreflect.Message {
 	mi := &file_message_proto_msgTypes[31]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2445,14 +3173,2702 @@ func (x *UserInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UserInfo.ProtoReflect.Descriptor instead.
-func (*UserInfo) Descriptor() ([]byte, []int) {
+// Deprecated: Use RequestLobbySetSpectators.ProtoReflect.Descriptor instead.
+func (*RequestLobbySetSpectators) Descriptor() ([]byte, []int) {
 	return file_message_proto_rawDescGZIP(), []int{31}
 }
 
-func (x *UserInfo) GetUserId() int32 {
+func (x *RequestLobbySetSpectators) GetLobbyID() uint64 {
 	if x != nil {
-		return x.UserId
+		return x.LobbyID
+	}
+	return 0
+}
+
+func (x *RequestLobbySetSpectators) GetAllow() bool {
+	if x != nil {
+		return x.Allow
+	}
+	return false
+}
+
+// a response bigger than the negotiated message size is split into fragmentCount frames,
+// every frame has the same type and holdValue, the client joins their bodies by fragmentIndex
+type ResponseHeader struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type          ResponseHeader_ResponseMessageType `protobuf:"varint,1,opt,name=type,proto3,enum=Paper.ResponseHeader_ResponseMessageType" json:"type,omitempty"`
+	Length        int32                              `protobuf:"varint,2,opt,name=length,proto3" json:"length,omitempty"`
+	HoldValue     int32                              `protobuf:"varint,3,opt,name=holdValue,proto3" json:"holdValue,omitempty"`
+	FragmentIndex uint32                             `protobuf:"varint,4,opt,name=fragmentIndex,proto3" json:"fragmentIndex,omitempty"`
+	FragmentCount uint32                             `protobuf:"varint,5,opt,name=fragmentCount,proto3" json:"fragmentCount,omitempty"` // 0 or 1 means the message is not split
+	TotalLength   uint32                             `protobuf:"varint,6,opt,name=totalLength,proto3" json:"totalLength,omitempty"`
+}
+
+func (x *ResponseHeader) Reset() {
+	*x = ResponseHeader{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_message_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResponseHeader) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResponseHeader) ProtoMessage() {}
+
+func (x *ResponseHeader) ProtoReflect() protoreflect.Message {
+	mi := &file_message_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResponseHeader.ProtoReflect.Descriptor instead.
+func (*ResponseHeader) Descriptor() ([]byte, []int) {
+	return file_message_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *ResponseHeader) GetType() ResponseHeader_ResponseMessageType {
+	if x != nil {
+		return x.Type
+	}
+	return ResponseHeader_Time
+}
+
+func (x *ResponseHeader) GetLength() int32 {
+	if x != nil {
+		return x.Length
+	}
+	return 0
+}
+
+func (x *ResponseHeader) GetHoldValue() int32 {
+	if x != nil {
+		return x.HoldValue
+	}
+	return 0
+}
+
+func (x *ResponseHeader) GetFragmentIndex() uint32 {
+	if x != nil {
+		return x.FragmentIndex
+	}
+	return 0
+}
+
+func (x *ResponseHeader) GetFragmentCount() uint32 {
+	if x != nil {
+		return x.FragmentCount
+	}
+	return 0
+}
+
+func (x *ResponseHeader) GetTotalLength() uint32 {
+	if x != nil {
+		return x.TotalLength
+	}
+	return 0
+}
+
+// sent after login if the client tells its maxMessageSize
+type ResponseMessageSizeLimits struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MaxRequestSize uint32 `protobuf:"varint,1,opt,name=maxRequestSize,proto3" json:"maxRequestSize,omitempty"`
+	MaxMessageSize uint32 `protobuf:"varint,2,opt,name=maxMessageSize,proto3" json:"maxMessageSize,omitempty"`
+	Fragments      bool   `protobuf:"varint,3,opt,name=fragments,proto3" json:"fragments,omitempty"`
+}
+
+func (x *ResponseMessageSizeLimits) Reset() {
+	*x = ResponseMessageSizeLimits{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_message_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResponseMessageSizeLimits) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResponseMessageSizeLimits) ProtoMessage() {}
+
+func (x *ResponseMessageSizeLimits) ProtoReflect() protoreflect.Message {
+	mi := &file_message_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResponseMessageSizeLimits.ProtoReflect.Descriptor instead.
+func (*ResponseMessageSizeLimits) Descriptor() ([]byte, []int) {
+	return file_message_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *ResponseMessageSizeLimits) GetMaxRequestSize() uint32 {
+	if x != nil {
+		return x.MaxRequestSize
+	}
+	return 0
+}
+
+func (x *ResponseMessageSizeLimits) GetMaxMessageSize() uint32 {
+	if x != nil {
+		return x.MaxMessageSize
+	}
+	return 0
+}
+
+func (x *ResponseMessageSizeLimits) GetFragments() bool {
+	if x != nil {
+		return x.Fragments
+	}
+	return false
+}
+
+// the server can't handle a request, the holdValue is the same as the expected response
+type ResponseRequestFailed struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ResponseType ResponseHeader_ResponseMessageType `protobuf:"varint,1,opt,name=responseType,proto3,enum=Paper.ResponseHeader_ResponseMessageType" json:"responseType,omitempty"`
+	Reason       ResponseRequestFailed_Reason       `protobuf:"varint,2,opt,name=reason,proto3,enum=Paper.ResponseRequestFailed_Reason" json:"reason,omitempty"`
+	Message      string                             `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *ResponseRequestFailed) Reset() {
+	*x = ResponseRequestFailed{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_message_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResponseRequestFailed) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResponseRequestFailed) ProtoMessage() {}
+
+func (x *ResponseRequestFailed) ProtoReflect() protoreflect.Message {
+	mi := &file_message_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResponseRequestFailed.ProtoReflect.Descriptor instead.
+func (*ResponseRequestFailed) Descriptor() ([]byte, []int) {
+	return file_message_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *ResponseRequestFailed) GetResponseType() ResponseHeader_ResponseMessageType {
+	if x != nil {
+		return x.ResponseType
+	}
+	return ResponseHeader_Time
+}
+
+func (x *ResponseRequestFailed) GetReason() ResponseRequestFailed_Reason {
+	if x != nil {
+		return x.Reason
+	}
+	return ResponseRequestFailed_Unknown
+}
+
+func (x *ResponseRequestFailed) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// the changes are sent to the lobby members with one LobbyDataUpdate or LobbyMemberDataUpdate
+type ResponseUpdateLobbyData struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Success bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Current []*LobbyDataUpdateItem `protobuf:"bytes,2,rep,name=current,proto3" json:"current,omitempty"` // the current values of the expected keys if not success
+}
+
+func (x *ResponseUpdateLobbyData) Reset() {
+	*x = ResponseUpdateLobbyData{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_message_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResponseUpdateLobbyData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResponseUpdateLobbyData) ProtoMessage() {}
+
+func (x *ResponseUpdateLobbyData) ProtoReflect() protoreflect.Message {
+	mi := &file_message_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResponseUpdateLobbyData.ProtoReflect.Descriptor instead.
+func (*ResponseUpdateLobbyData) Descriptor() ([]byte, []int) {
+	return file_message_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *ResponseUpdateLobbyData) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *ResponseUpdateLobbyData) GetCurrent() []*LobbyDataUpdateItem {
+	if x != nil {
+		return x.Current
+	}
+	return nil
+}
+
+// sent to the owner when a user wants to join a lobby that requires approval, the
+// requester gets the LobbyJoin response after the owner answers or the request times out
+type ResponseLobbyJoinRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LobbyId   uint64 `protobuf:"varint,1,opt,name=lobbyId,proto3" json:"lobbyId,omitempty"`
+	SteamId   uint64 `protobuf:"varint,2,opt,name=steamId,proto3" json:"steamId,omitempty"`
+	Name      string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Cancelled bool   `protobuf:"varint,4,opt,name=cancelled,proto3" json:"cancelled,omitempty"` // the request is gone, no need to answer it
+}
+
+func (x *ResponseLobbyJoinRequest) Reset() {
+	*x = ResponseLobbyJoinRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_message_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResponseLobbyJoinRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResponseLobbyJoinRequest) ProtoMessage() {}
+
+func (x *ResponseLobbyJoinRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_message_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResponseLobbyJoinRequest.ProtoReflect.Descriptor instead.
+func (*ResponseLobbyJoinRequest) Descriptor() ([]byte, []int) {
+	return file_message_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *ResponseLobbyJoinRequest) GetLobbyId() uint64 {
+	if x != nil {
+		return x.LobbyId
+	}
+	return 0
+}
+
+func (x *ResponseLobbyJoinRequest) GetSteamId() uint64 {
+	if x != nil {
+		return x.SteamId
+	}
+	return 0
+}
+
+func (x *ResponseLobbyJoinRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ResponseLobbyJoinRequest) GetCancelled() bool {
+	if x != nil {
+		return x.Cancelled
+	}
+	return false
+}
+
+type ResponseRelationList struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Friends []*RelationEntry `protobuf:"bytes,1,rep,name=friends,proto3" json:"friends,omitempty"`
+	Blocks  []*RelationEntry `protobuf:"bytes,2,rep,name=blocks,proto3" json:"blocks,omitempty"`
+}
+
+func (x *ResponseRelationList) Reset() {
+	*x = ResponseRelationList{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_message_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResponseRelationList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResponseRelationList) ProtoMessage() {}
+
+func (x *ResponseRelationList) ProtoReflect() protoreflect.Message {
+	mi := &file_message_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResponseRelationList.ProtoReflect.Descriptor instead.
+func (*ResponseRelationList) Descriptor() ([]byte, []int) {
+	return file_message_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *ResponseRelationList) GetFriends() []*RelationEntry {
+	if x != nil {
+		return x.Friends
+	}
+	return nil
+}
+
+func (x *ResponseRelationList) GetBlocks() []*RelationEntry {
+	if x != nil {
+		return x.Blocks
+	}
+	return nil
+}
+
+type RelationEntry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SteamId uint64 `protobuf:"varint,1,opt,name=steamId,proto3" json:"steamId,omitempty"`
+	Name    string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"` // the last known name
+	Online  bool   `protobuf:"varint,3,opt,name=online,proto3" json:"online,omitempty"`
+}
+
+func (x *RelationEntry) Reset() {
+	*x = RelationEntry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_message_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RelationEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RelationEntry) ProtoMessage() {}
+
+func (x *RelationEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_message_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RelationEntry.ProtoReflect.Descriptor instead.
+func (*RelationEntry) Descriptor() ([]byte, []int) {
+	return file_message_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *RelationEntry) GetSteamId() uint64 {
+	if x != nil {
+		return x.SteamId
+	}
+	return 0
+}
+
+func (x *RelationEntry) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *RelationEntry) GetOnline() bool {
+	if x != nil {
+		return x.Online
+	}
+	return false
+}
+
+// sent when a user on the friend list comes online or goes offline
+type ResponseFriendPresence struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SteamId uint64 `protobuf:"varint,1,opt,name=steamId,proto3" json:"steamId,omitempty"`
+	Name    string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Online  bool   `protobuf:"varint,3,opt,name=online,proto3" json:"online,omitempty"`
+}
+
+func (x *ResponseFriendPresence) Reset() {
+	*x = ResponseFriendPresence{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_message_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResponseFriendPresence) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResponseFriendPresence) ProtoMessage() {}
+
+func (x *ResponseFriendPresence) ProtoReflect() protoreflect.Message {
+	mi := &file_message_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResponseFriendPresence.ProtoReflect.Descriptor instead.
+func (*ResponseFriendPresence) Descriptor() ([]byte, []int) {
+	return file_message_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *ResponseFriendPresence) GetSteamId() uint64 {
+	if x != nil {
+		return x.SteamId
+	}
+	return 0
+}
+
+func (x *ResponseFriendPresence) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ResponseFriendPresence) GetOnline() bool {
+	if x != nil {
+		return x.Online
+	}
+	return false
+}
+
+type PlayerPresence struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SteamId    uint64        `protobuf:"varint,1,opt,name=steamId,proto3" json:"steamId,omitempty"`
+	Name       string        `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	State      PresenceState `protobuf:"varint,3,opt,name=state,proto3,enum=Paper.PresenceState" json:"state,omitempty"`
+	LobbyId    uint64        `protobuf:"varint,4,opt,name=lobbyId,proto3" json:"lobbyId,omitempty"` // 0 if the lobby is hidden from the viewer
+	LobbyName  string        `protobuf:"bytes,5,opt,name=lobbyName,proto3" json:"lobbyName,omitempty"`
+	Joinable   bool          `protobuf:"varint,6,opt,name=joinable,proto3" json:"joinable,omitempty"`
+	Spectating bool          `protobuf:"varint,7,opt,name=spectating,proto3" json:"spectating,omitempty"`
+}
+
+func (x *PlayerPresence) Reset() {
+	*x = PlayerPresence{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_message_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PlayerPresence) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlayerPresence) ProtoMessage() {}
+
+func (x *PlayerPresence) ProtoReflect() protoreflect.Message {
+	mi := &file_message_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlayerPresence.ProtoReflect.Descriptor instead.
+func (*PlayerPresence) Descriptor() ([]byte, []int) {
+	return file_message_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *PlayerPresence) GetSteamId() uint64 {
+	if x != nil {
+		return x.SteamId
+	}
+	return 0
+}
+
+func (x *PlayerPresence) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PlayerPresence) GetState() PresenceState {
+	if x != nil {
+		return x.State
+	}
+	return PresenceState_Offline
+}
+
+func (x *PlayerPresence) GetLobbyId() uint64 {
+	if x != nil {
+		return x.LobbyId
+	}
+	return 0
+}
+
+func (x *PlayerPresence) GetLobbyName() string {
+	if x != nil {
+		return x.LobbyName
+	}
+	return ""
+}
+
+func (x *PlayerPresence) GetJoinable() bool {
+	if x != nil {
+		return x.Joinable
+	}
+	return false
+}
+
+func (x *PlayerPresence) GetSpectating() bool {
+	if x != nil {
+		return x.Spectating
+	}
+	return false
+}
+
+type ResponsePresence struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Players []*PlayerPresence `protobuf:"bytes,1,rep,name=players,proto3" json:"players,omitempty"`
+}
+
+func (x *ResponsePresence) Reset() {
+	*x = ResponsePresence{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_message_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResponsePresence) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResponsePresence) ProtoMessage() {}
+
+func (x *ResponsePresence) ProtoReflect() protoreflect.Message {
+	mi := &file_message_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResponsePresence.ProtoReflect.Descriptor instead.
+func (*ResponsePresence) Descriptor() ([]byte, []int) {
+	return file_message_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *ResponsePresence) GetPlayers() []*PlayerPresence {
+	if x != nil {
+		return x.Players
+	}
+	return nil
+}
+
+// only the changed players are sent
+type ResponsePresenceUpdate struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Players []*PlayerPresence `protobuf:"bytes,1,rep,name=players,proto3" json:"players,omitempty"`
+}
+
+func (x *ResponsePresenceUpdate) Reset() {
+	*x = ResponsePresenceUpdate{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_message_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResponsePresenceUpdate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResponsePresenceUpdate) ProtoMessage() {}
+
+func (x *ResponsePresenceUpdate) ProtoReflect() protoreflect.Message {
+	mi := &file_message_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResponsePresenceUpdate.ProtoReflect.Descriptor instead.
+func (*ResponsePresenceUpdate) Descriptor() ([]byte, []int) {
+	return file_message_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *ResponsePresenceUpdate) GetPlayers() []*PlayerPresence {
+	if x != nil {
+		return x.Players
+	}
+	return nil
+}
+
+type ResponseLobbyInviteCode struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LobbyId uint64 `protobuf:"varint,1,opt,name=lobbyId,proto3" json:"lobbyId,omitempty"`
+	Code    string `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"` // empty if the lobby has no code
+}
+
+func (x *ResponseLobbyInviteCode) Reset() {
+	*x = ResponseLobbyInviteCode{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_message_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResponseLobbyInviteCode) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResponseLobbyInviteCode) ProtoMessage() {}
+
+func (x *ResponseLobbyInviteCode) ProtoReflect() protoreflect.Message {
+	mi := &file_message_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResponseLobbyInviteCode.ProtoReflect.Descriptor instead.
+func (*ResponseLobbyInviteCode) Descriptor() ([]byte, []int) {
+	return file_message_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *ResponseLobbyInviteCode) GetLobbyId() uint64 {
+	if x != nil {
+		return x.LobbyId
+	}
+	return 0
+}
+
+func (x *ResponseLobbyInviteCode) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+// the client should join the lobby when the status is Matched
+type ResponseMatchmakeStatus struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status        ResponseMatchmakeStatus_Status `protobuf:"varint,1,opt,name=status,proto3,enum=Paper.ResponseMatchmakeStatus_Status" json:"status,omitempty"`
+	LobbyId       uint64                         `protobuf:"varint,2,opt,name=lobbyId,proto3" json:"lobbyId,omitempty"`
+	QueuePosition uint32                         `protobuf:"varint,3,opt,name=queuePosition,proto3" json:"queuePosition,omitempty"` // starts from 1
+	QueueSize     uint32                         `protobuf:"varint,4,opt,name=queueSize,proto3" json:"queueSize,omitempty"`
+}
+
+func (x *ResponseMatchmakeStatus) Reset() {
+	*x = ResponseMatchmakeStatus{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_message_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResponseMatchmakeStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResponseMatchmakeStatus) ProtoMessage() {}
+
+func (x *ResponseMatchmakeStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_message_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResponseMatchmakeStatus.ProtoReflect.Descriptor instead.
+func (*ResponseMatchmakeStatus) Descriptor() ([]byte, []int) {
+	return file_message_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *ResponseMatchmakeStatus) GetStatus() ResponseMatchmakeStatus_Status {
+	if x != nil {
+		return x.Status
+	}
+	return ResponseMatchmakeStatus_Queued
+}
+
+func (x *ResponseMatchmakeStatus) GetLobbyId() uint64 {
+	if x != nil {
+		return x.LobbyId
+	}
+	return 0
+}
+
+func (x *ResponseMatchmakeStatus) GetQueuePosition() uint32 {
+	if x != nil {
+		return x.QueuePosition
+	}
+	return 0
+}
+
+func (x *ResponseMatchmakeStatus) GetQueueSize() uint32 {
+	if x != nil {
+		return x.QueueSize
+	}
+	return 0
+}
+
+type ResponseLogConsoleChatFastMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Msgs []string `protobuf:"bytes,1,rep,name=msgs,proto3" json:"msgs,omitempty"`
+}
+
+func (x *ResponseLogConsoleChatFastMessage) Reset() {
+	*x = ResponseLogConsoleChatFastMessage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_message_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResponseLogConsoleChatFastMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResponseLogConsoleChatFastMessage) ProtoMessage() {}
+
+func (x *ResponseLogConsoleChatFastMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_message_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResponseLogConsoleChatFastMessage.ProtoReflect.Descriptor instead.
+func (*ResponseLogConsoleChatFastMessage) Descriptor() ([]byte, []int) {
+	return file_message_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *ResponseLogConsoleChatFastMessage) GetMsgs() []string {
+	if x != nil {
+		return x.Msgs
+	}
+	return nil
+}
+
+// the names may contain "{n}", which is replaced by a number so the name is unique
+type ResponseCreateRoomNameLists struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Names     []string             `protobuf:"bytes,1,rep,name=names,proto3" json:"names,omitempty"`
+	Templates []*LobbyTemplateInfo `protobuf:"bytes,2,rep,name=templates,proto3" json:"templates,omitempty"`
+}
+
+func (x *ResponseCreateRoomNameLists) Reset() {
+	*x = ResponseCreateRoomNameLists{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_message_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResponseCreateRoomNameLists) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResponseCreateRoomNameLists) ProtoMessage() {}
+
+func (x *ResponseCreateRoomNameLists) ProtoReflect() protoreflect.Message {
+	mi := &file_message_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResponseCreateRoomNameLists.ProtoReflect.Descriptor instead.
+func (*ResponseCreateRoomNameLists) Descriptor() ([]byte, []int) {
+	return file_message_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *ResponseCreateRoomNameLists) GetNames() []string {
+	if x != nil {
+		return x.Names
+	}
+	return nil
+}
+
+func (x *ResponseCreateRoomNameLists) GetTemplates() []*LobbyTemplateInfo {
+	if x != nil {
+		return x.Templates
+	}
+	return nil
+}
+
+type LobbyTemplateInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key        string          `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Name       string          `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	LobbyGroup int32           `protobuf:"varint,3,opt,name=lobbyGroup,proto3" json:"lobbyGroup,omitempty"`
+	MaxMembers int32           `protobuf:"varint,4,opt,name=maxMembers,proto3" json:"maxMembers,omitempty"`
+	EnableP2P  bool            `protobuf:"varint,5,opt,name=enableP2P,proto3" json:"enableP2P,omitempty"`
+	Visibility LobbyVisibility `protobuf:"varint,6,opt,name=visibility,proto3,enum=Paper.LobbyVisibility" json:"visibility,omitempty"`
+}
+
+func (x *LobbyTemplateInfo) Reset() {
+	*x = LobbyTemplateInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_message_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LobbyTemplateInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LobbyTemplateInfo) ProtoMessage() {}
+
+func (x *LobbyTemplateInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_message_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LobbyTemplateInfo.ProtoReflect.Descriptor instead.
+func (*LobbyTemplateInfo) Descriptor() ([]byte, []int) {
+	return file_message_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *LobbyTemplateInfo) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *LobbyTemplateInfo) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *LobbyTemplateInfo) GetLobbyGroup() int32 {
+	if x != nil {
+		return x.LobbyGroup
+	}
+	return 0
+}
+
+func (x *LobbyTemplateInfo) GetMaxMembers() int32 {
+	if x != nil {
+		return x.MaxMembers
+	}
+	return 0
+}
+
+func (x *LobbyTemplateInfo) GetEnableP2P() bool {
+	if x != nil {
+		return x.EnableP2P
+	}
+	return false
+}
+
+func (x *LobbyTemplateInfo) GetVisibility() LobbyVisibility {
+	if x != nil {
+		return x.Visibility
+	}
+	return LobbyVisibility_Public
+}
+
+type ResponseTime struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Timestamp uint32 `protobuf:"varint,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+}
+
+func (x *ResponseTime) Reset() {
+	*x = ResponseTime{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_message_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResponseTime) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResponseTime) ProtoMessage() {}
+
+func (x *ResponseTime) ProtoReflect() protoreflect.Message {
+	mi := &file_message_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResponseTime.ProtoReflect.Descriptor instead.
+func (*ResponseTime) Descriptor() ([]byte, []int) {
+	return file_message_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *ResponseTime) GetTimestamp() uint32 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+type LobbyInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LobbyId             uint64                 `protobuf:"varint,1,opt,name=lobbyId,proto3" json:"lobbyId,omitempty"`
+	OwnerId             uint64                 `protobuf:"varint,2,opt,name=ownerId,proto3" json:"ownerId,omitempty"`
+	UserIds             []uint64               `protobuf:"varint,3,rep,packed,name=userIds,proto3" json:"userIds,omitempty"`
+	Datas               []*LobbyDataUpdateItem `protobuf:"bytes,4,rep,name=datas,proto3" json:"datas,omitempty"`
+	UsersDatas          []*SingleUserData      `protobuf:"bytes,5,rep,name=usersDatas,proto3" json:"usersDatas,omitempty"`
+	Name                string                 `protobuf:"bytes,6,opt,name=name,proto3" json:"name,omitempty"`
+	HasPassword         bool                   `protobuf:"varint,7,opt,name=hasPassword,proto3" json:"hasPassword,omitempty"`
+	Password            *string                `protobuf:"bytes,8,opt,name=password,proto3,oneof" json:"password,omitempty"` // sometimes, the server tells the client password.
+	NotJoinable         bool                   `protobuf:"varint,9,opt,name=notJoinable,proto3" json:"notJoinable,omitempty"`
+	MaxMembers          int32                  `protobuf:"varint,10,opt,name=maxMembers,proto3" json:"maxMembers,omitempty"`
+	SpectatorIds        []uint64               `protobuf:"varint,11,rep,packed,name=spectatorIds,proto3" json:"spectatorIds,omitempty"`
+	SpectatorsForbidden bool                   `protobuf:"varint,12,opt,name=spectatorsForbidden,proto3" json:"spectatorsForbidden,omitempty"`
+	LobbyGroup          int32                  `protobuf:"varint,13,opt,name=lobbyGroup,proto3" json:"lobbyGroup,omitempty"`
+	Visibility          LobbyVisibility        `protobuf:"varint,14,opt,name=visibility,proto3,enum=Paper.LobbyVisibility" json:"visibility,omitempty"`
+	RequireApproval     bool                   `protobuf:"varint,15,opt,name=requireApproval,proto3" json:"requireApproval,omitempty"`
+}
+
+func (x *LobbyInfo) Reset() {
+	*x = LobbyInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_message_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LobbyInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LobbyInfo) ProtoMessage() {}
+
+func (x *LobbyInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_message_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LobbyInfo.ProtoReflect.Descriptor instead.
+func (*LobbyInfo) Descriptor() ([]byte, []int) {
+	return file_message_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *LobbyInfo) GetLobbyId() uint64 {
+	if x != nil {
+		return x.LobbyId
+	}
+	return 0
+}
+
+func (x *LobbyInfo) GetOwnerId() uint64 {
+	if x != nil {
+		return x.OwnerId
+	}
+	return 0
+}
+
+func (x *LobbyInfo) GetUserIds() []uint64 {
+	if x != nil {
+		return x.UserIds
+	}
+	return nil
+}
+
+func (x *LobbyInfo) GetDatas() []*LobbyDataUpdateItem {
+	if x != nil {
+		return x.Datas
+	}
+	return nil
+}
+
+func (x *LobbyInfo) GetUsersDatas() []*SingleUserData {
+	if x != nil {
+		return x.UsersDatas
+	}
+	return nil
+}
+
+func (x *LobbyInfo) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *LobbyInfo) GetHasPassword() bool {
+	if x != nil {
+		return x.HasPassword
+	}
+	return false
+}
+
+func (x *LobbyInfo) GetPassword() string {
+	if x != nil && x.Password != nil {
+		return *x.Password
+	}
+	return ""
+}
+
+func (x *LobbyInfo) GetNotJoinable() bool {
+	if x != nil {
+		return x.NotJoinable
+	}
+	return false
+}
+
+func (x *LobbyInfo) GetMaxMembers() int32 {
+	if x != nil {
+		return x.MaxMembers
+	}
+	return 0
+}
+
+func (x *LobbyInfo) GetSpectatorIds() []uint64 {
+	if x != nil {
+		return x.SpectatorIds
+	}
+	return nil
+}
+
+func (x *LobbyInfo) GetSpectatorsForbidden() bool {
+	if x != nil {
+		return x.SpectatorsForbidden
+	}
+	return false
+}
+
+func (x *LobbyInfo) GetLobbyGroup() int32 {
+	if x != nil {
+		return x.LobbyGroup
+	}
+	return 0
+}
+
+func (x *LobbyInfo) GetVisibility() LobbyVisibility {
+	if x != nil {
+		return x.Visibility
+	}
+	return LobbyVisibility_Public
+}
+
+func (x *LobbyInfo) GetRequireApproval() bool {
+	if x != nil {
+		return x.RequireApproval
+	}
+	return false
+}
+
+type SingleUserDataItem struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	K string `protobuf:"bytes,1,opt,name=K,proto3" json:"K,omitempty"`
+	V string `protobuf:"bytes,2,opt,name=V,proto3" json:"V,omitempty"`
+}
+
+func (x *SingleUserDataItem) Reset() {
+	*x = SingleUserDataItem{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_message_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SingleUserDataItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SingleUserDataItem) ProtoMessage() {}
+
+func (x *SingleUserDataItem) ProtoReflect() protoreflect.Message {
+	mi := &file_message_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SingleUserDataItem.ProtoReflect.Descriptor instead.
+func (*SingleUserDataItem) Descriptor() ([]byte, []int) {
+	return file_message_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *SingleUserDataItem) GetK() string {
+	if x != nil {
+		return x.K
+	}
+	return ""
+}
+
+func (x *SingleUserDataItem) GetV() string {
+	if x != nil {
+		return x.V
+	}
+	return ""
+}
+
+type SingleUserData struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Data      []*SingleUserDataItem `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+	UdpIpAddr []byte                `protobuf:"bytes,2,opt,name=udpIpAddr,proto3" json:"udpIpAddr,omitempty"`
+	UdpPort   int32                 `protobuf:"varint,3,opt,name=udpPort,proto3" json:"udpPort,omitempty"`
+}
+
+func (x *SingleUserData) Reset() {
+	*x = SingleUserData{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_message_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SingleUserData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SingleUserData) ProtoMessage() {}
+
+func (x *SingleUserData) ProtoReflect() protoreflect.Message {
+	mi := &file_message_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SingleUserData.ProtoReflect.Descriptor instead.
+func (*SingleUserData) Descriptor() ([]byte, []int) {
+	return file_message_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *SingleUserData) GetData() []*SingleUserDataItem {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *SingleUserData) GetUdpIpAddr() []byte {
+	if x != nil {
+		return x.UdpIpAddr
+	}
+	return nil
+}
+
+func (x *SingleUserData) GetUdpPort() int32 {
+	if x != nil {
+		return x.UdpPort
+	}
+	return 0
+}
+
+type ResponseUserAddr struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Lobbypos  int32  `protobuf:"varint,1,opt,name=lobbypos,proto3" json:"lobbypos,omitempty"`
+	UdpIpAddr []byte `protobuf:"bytes,2,opt,name=udpIpAddr,proto3" json:"udpIpAddr,omitempty"`
+	UdpPort   int32  `protobuf:"varint,3,opt,name=udpPort,proto3" json:"udpPort,omitempty"`
+}
+
+func (x *ResponseUserAddr) Reset() {
+	*x = ResponseUserAddr{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_message_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResponseUserAddr) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResponseUserAddr) ProtoMessage() {}
+
+func (x *ResponseUserAddr) ProtoReflect() protoreflect.Message {
+	mi := &file_message_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResponseUserAddr.ProtoReflect.Descriptor instead.
+func (*ResponseUserAddr) Descriptor() ([]byte, []int) {
+	return file_message_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *ResponseUserAddr) GetLobbypos() int32 {
+	if x != nil {
+		return x.Lobbypos
+	}
+	return 0
+}
+
+func (x *ResponseUserAddr) GetUdpIpAddr() []byte {
+	if x != nil {
+		return x.UdpIpAddr
+	}
+	return nil
+}
+
+func (x *ResponseUserAddr) GetUdpPort() int32 {
+	if x != nil {
+		return x.UdpPort
+	}
+	return 0
+}
+
+type ResponseLobbyList struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Lobbies    []*LobbyInfo `protobuf:"bytes,1,rep,name=lobbies,proto3" json:"lobbies,omitempty"`
+	NextCursor string       `protobuf:"bytes,2,opt,name=nextCursor,proto3" json:"nextCursor,omitempty"`  // empty if this is the last page
+	TotalCount uint32       `protobuf:"varint,3,opt,name=totalCount,proto3" json:"totalCount,omitempty"` // the count of all matched lobbies
+}
+
+func (x *ResponseLobbyList) Reset() {
+	*x = ResponseLobbyList{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_message_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResponseLobbyList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResponseLobbyList) ProtoMessage() {}
+
+func (x *ResponseLobbyList) ProtoReflect() protoreflect.Message {
+	mi := &file_message_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResponseLobbyList.ProtoReflect.Descriptor instead.
+func (*ResponseLobbyList) Descriptor() ([]byte, []int) {
+	return file_message_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *ResponseLobbyList) GetLobbies() []*LobbyInfo {
+	if x != nil {
+		return x.Lobbies
+	}
+	return nil
+}
+
+func (x *ResponseLobbyList) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+func (x *ResponseLobbyList) GetTotalCount() uint32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+// the changes of the lobby list from baseVersion to version, the client should
+// subscribe again if its version is not the baseVersion
+type ResponseLobbyListDelta struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BaseVersion uint64            `protobuf:"varint,1,opt,name=baseVersion,proto3" json:"baseVersion,omitempty"`
+	Version     uint64            `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	Snapshot    bool              `protobuf:"varint,3,opt,name=snapshot,proto3" json:"snapshot,omitempty"` // the added lobbies are the whole list
+	Added       []*LobbyInfo      `protobuf:"bytes,4,rep,name=added,proto3" json:"added,omitempty"`
+	Updated     []*LobbyInfo      `protobuf:"bytes,5,rep,name=updated,proto3" json:"updated,omitempty"`
+	Removed     []uint64          `protobuf:"varint,6,rep,packed,name=removed,proto3" json:"removed,omitempty"`
+	Patched     []*LobbyInfoPatch `protobuf:"bytes,7,rep,name=patched,proto3" json:"patched,omitempty"` // the lobbies whose members or data are changed only
+}
+
+func (x *ResponseLobbyListDelta) Reset() {
+	*x = ResponseLobbyListDelta{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_message_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResponseLobbyListDelta) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResponseLobbyListDelta) ProtoMessage() {}
+
+func (x *ResponseLobbyListDelta) ProtoReflect() protoreflect.Message {
+	mi := &file_message_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResponseLobbyListDelta.ProtoReflect.Descriptor instead.
+func (*ResponseLobbyListDelta) Descriptor() ([]byte, []int) {
+	return file_message_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *ResponseLobbyListDelta) GetBaseVersion() uint64 {
+	if x != nil {
+		return x.BaseVersion
+	}
+	return 0
+}
+
+func (x *ResponseLobbyListDelta) GetVersion() uint64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *ResponseLobbyListDelta) GetSnapshot() bool {
+	if x != nil {
+		return x.Snapshot
+	}
+	return false
+}
+
+func (x *ResponseLobbyListDelta) GetAdded() []*LobbyInfo {
+	if x != nil {
+		return x.Added
+	}
+	return nil
+}
+
+func (x *ResponseLobbyListDelta) GetUpdated() []*LobbyInfo {
+	if x != nil {
+		return x.Updated
+	}
+	return nil
+}
+
+func (x *ResponseLobbyListDelta) GetRemoved() []uint64 {
+	if x != nil {
+		return x.Removed
+	}
+	return nil
+}
+
+func (x *ResponseLobbyListDelta) GetPatched() []*LobbyInfoPatch {
+	if x != nil {
+		return x.Patched
+	}
+	return nil
+}
+
+// the changes of a lobby the client already has, apply them to its LobbyInfo
+type LobbyInfoPatch struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LobbyId     uint64                 `protobuf:"varint,1,opt,name=lobbyId,proto3" json:"lobbyId,omitempty"`
+	MemberCount uint32                 `protobuf:"varint,2,opt,name=memberCount,proto3" json:"memberCount,omitempty"`
+	UserIds     []uint64               `protobuf:"varint,3,rep,packed,name=userIds,proto3" json:"userIds,omitempty"` // empty if the members are not changed
+	Datas       []*LobbyDataUpdateItem `protobuf:"bytes,4,rep,name=datas,proto3" json:"datas,omitempty"`             // the added or changed data
+	DeletedKeys []string               `protobuf:"bytes,5,rep,name=deletedKeys,proto3" json:"deletedKeys,omitempty"`
+}
+
+func (x *LobbyInfoPatch) Reset() {
+	*x = LobbyInfoPatch{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_message_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LobbyInfoPatch) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LobbyInfoPatch) ProtoMessage() {}
+
+func (x *LobbyInfoPatch) ProtoReflect() protoreflect.Message {
+	mi := &file_message_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LobbyInfoPatch.ProtoReflect.Descriptor instead.
+func (*LobbyInfoPatch) Descriptor() ([]byte, []int) {
+	return file_message_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *LobbyInfoPatch) GetLobbyId() uint64 {
+	if x != nil {
+		return x.LobbyId
+	}
+	return 0
+}
+
+func (x *LobbyInfoPatch) GetMemberCount() uint32 {
+	if x != nil {
+		return x.MemberCount
+	}
+	return 0
+}
+
+func (x *LobbyInfoPatch) GetUserIds() []uint64 {
+	if x != nil {
+		return x.UserIds
+	}
+	return nil
+}
+
+func (x *LobbyInfoPatch) GetDatas() []*LobbyDataUpdateItem {
+	if x != nil {
+		return x.Datas
+	}
+	return nil
+}
+
+func (x *LobbyInfoPatch) GetDeletedKeys() []string {
+	if x != nil {
+		return x.DeletedKeys
+	}
+	return nil
+}
+
+type ResponseLobbyCreated struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LobbyId uint64     `protobuf:"varint,1,opt,name=lobbyId,proto3" json:"lobbyId,omitempty"`
+	Info    *LobbyInfo `protobuf:"bytes,2,opt,name=info,proto3" json:"info,omitempty"`
+}
+
+func (x *ResponseLobbyCreated) Reset() {
+	*x = ResponseLobbyCreated{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_message_proto_msgTypes[56]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResponseLobbyCreated) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResponseLobbyCreated) ProtoMessage() {}
+
+func (x *ResponseLobbyCreated) ProtoReflect() protoreflect.Message {
+	mi := &file_message_proto_msgTypes[56]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResponseLobbyCreated.ProtoReflect.Descriptor instead.
+func (*ResponseLobbyCreated) Descriptor() ([]byte, []int) {
+	return file_message_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *ResponseLobbyCreated) GetLobbyId() uint64 {
+	if x != nil {
+		return x.LobbyId
+	}
+	return 0
+}
+
+func (x *ResponseLobbyCreated) GetInfo() *LobbyInfo {
+	if x != nil {
+		return x.Info
+	}
+	return nil
+}
+
+type ResponseUpdateUserInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId uint64 `protobuf:"varint,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	Name   string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *ResponseUpdateUserInfo) Reset() {
+	*x = ResponseUpdateUserInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_message_proto_msgTypes[57]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResponseUpdateUserInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResponseUpdateUserInfo) ProtoMessage() {}
+
+func (x *ResponseUpdateUserInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_message_proto_msgTypes[57]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResponseUpdateUserInfo.ProtoReflect.Descriptor instead.
+func (*ResponseUpdateUserInfo) Descriptor() ([]byte, []int) {
+	return file_message_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *ResponseUpdateUserInfo) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *ResponseUpdateUserInfo) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type LobbyDataUpdateItem struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	K string `protobuf:"bytes,1,opt,name=k,proto3" json:"k,omitempty"`
+	V string `protobuf:"bytes,2,opt,name=v,proto3" json:"v,omitempty"`
+}
+
+func (x *LobbyDataUpdateItem) Reset() {
+	*x = LobbyDataUpdateItem{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_message_proto_msgTypes[58]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LobbyDataUpdateItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LobbyDataUpdateItem) ProtoMessage() {}
+
+func (x *LobbyDataUpdateItem) ProtoReflect() protoreflect.Message {
+	mi := &file_message_proto_msgTypes[58]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LobbyDataUpdateItem.ProtoReflect.Descriptor instead.
+func (*LobbyDataUpdateItem) Descriptor() ([]byte, []int) {
+	return file_message_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *LobbyDataUpdateItem) GetK() string {
+	if x != nil {
+		return x.K
+	}
+	return ""
+}
+
+func (x *LobbyDataUpdateItem) GetV() string {
+	if x != nil {
+		return x.V
+	}
+	return ""
+}
+
+type ResponseLobbyDataUpdate struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SteamIdLobby  uint64                 `protobuf:"varint,1,opt,name=steamIdLobby,proto3" json:"steamIdLobby,omitempty"`
+	SteamIdMember uint64                 `protobuf:"varint,2,opt,name=steamIdMember,proto3" json:"steamIdMember,omitempty"`
+	OnlyLobbyId   bool                   `protobuf:"varint,3,opt,name=onlyLobbyId,proto3" json:"onlyLobbyId,omitempty"`
+	Datas         []*LobbyDataUpdateItem `protobuf:"bytes,4,rep,name=datas,proto3" json:"datas,omitempty"`
+	DeletedKeys   []string               `protobuf:"bytes,5,rep,name=deletedKeys,proto3" json:"deletedKeys,omitempty"` // deleted before the datas are set
+}
+
+func (x *ResponseLobbyDataUpdate) Reset() {
+	*x = ResponseLobbyDataUpdate{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_message_proto_msgTypes[59]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResponseLobbyDataUpdate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResponseLobbyDataUpdate) ProtoMessage() {}
+
+func (x *ResponseLobbyDataUpdate) ProtoReflect() protoreflect.Message {
+	mi := &file_message_proto_msgTypes[59]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResponseLobbyDataUpdate.ProtoReflect.Descriptor instead.
+func (*ResponseLobbyDataUpdate) Descriptor() ([]byte, []int) {
+	return file_message_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *ResponseLobbyDataUpdate) GetSteamIdLobby() uint64 {
+	if x != nil {
+		return x.SteamIdLobby
+	}
+	return 0
+}
+
+func (x *ResponseLobbyDataUpdate) GetSteamIdMember() uint64 {
+	if x != nil {
+		return x.SteamIdMember
+	}
+	return 0
+}
+
+func (x *ResponseLobbyDataUpdate) GetOnlyLobbyId() bool {
+	if x != nil {
+		return x.OnlyLobbyId
+	}
+	return false
+}
+
+func (x *ResponseLobbyDataUpdate) GetDatas() []*LobbyDataUpdateItem {
+	if x != nil {
+		return x.Datas
+	}
+	return nil
+}
+
+func (x *ResponseLobbyDataUpdate) GetDeletedKeys() []string {
+	if x != nil {
+		return x.DeletedKeys
+	}
+	return nil
+}
+
+// sent to all lobby members whenever the owner of the lobby changes
+type ResponseLobbyOwnerChanged struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SteamIdLobby    uint64     `protobuf:"varint,1,opt,name=steamIdLobby,proto3" json:"steamIdLobby,omitempty"`
+	SteamIdOldOwner uint64     `protobuf:"varint,2,opt,name=steamIdOldOwner,proto3" json:"steamIdOldOwner,omitempty"`
+	SteamIdNewOwner uint64     `protobuf:"varint,3,opt,name=steamIdNewOwner,proto3" json:"steamIdNewOwner,omitempty"`
+	LobbyInfo       *LobbyInfo `protobuf:"bytes,4,opt,name=lobbyInfo,proto3" json:"lobbyInfo,omitempty"`
+}
+
+func (x *ResponseLobbyOwnerChanged) Reset() {
+	*x = ResponseLobbyOwnerChanged{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_message_proto_msgTypes[60]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResponseLobbyOwnerChanged) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResponseLobbyOwnerChanged) ProtoMessage() {}
+
+func (x *ResponseLobbyOwnerChanged) ProtoReflect() protoreflect.Message {
+	mi := &file_message_proto_msgTypes[60]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResponseLobbyOwnerChanged.ProtoReflect.Descriptor instead.
+func (*ResponseLobbyOwnerChanged) Descriptor() ([]byte, []int) {
+	return file_message_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *ResponseLobbyOwnerChanged) GetSteamIdLobby() uint64 {
+	if x != nil {
+		return x.SteamIdLobby
+	}
+	return 0
+}
+
+func (x *ResponseLobbyOwnerChanged) GetSteamIdOldOwner() uint64 {
+	if x != nil {
+		return x.SteamIdOldOwner
+	}
+	return 0
+}
+
+func (x *ResponseLobbyOwnerChanged) GetSteamIdNewOwner() uint64 {
+	if x != nil {
+		return x.SteamIdNewOwner
+	}
+	return 0
+}
+
+func (x *ResponseLobbyOwnerChanged) GetLobbyInfo() *LobbyInfo {
+	if x != nil {
+		return x.LobbyInfo
+	}
+	return nil
+}
+
+type ResponseLobbyChatUpdate struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SteamIdLobby               uint64                                        `protobuf:"varint,1,opt,name=steamIdLobby,proto3" json:"steamIdLobby,omitempty"`
+	SteamIdUserChanged         uint64                                        `protobuf:"varint,2,opt,name=steamIdUserChanged,proto3" json:"steamIdUserChanged,omitempty"`
+	SteamIdUserChangedIsLobby  bool                                          `protobuf:"varint,3,opt,name=steamIdUserChangedIsLobby,proto3" json:"steamIdUserChangedIsLobby,omitempty"`
+	SteamIdMakingChange        uint64                                        `protobuf:"varint,4,opt,name=steamIdMakingChange,proto3" json:"steamIdMakingChange,omitempty"`
+	SteamIdMakingChangeIsLobby bool                                          `protobuf:"varint,5,opt,name=steamIdMakingChangeIsLobby,proto3" json:"steamIdMakingChangeIsLobby,omitempty"`
+	ChatMemberStateChange      ResponseLobbyChatUpdate_ChatMemberStateChange `protobuf:"varint,6,opt,name=chatMemberStateChange,proto3,enum=Paper.ResponseLobbyChatUpdate_ChatMemberStateChange" json:"chatMemberStateChange,omitempty"`
+	LobbyInfo                  *LobbyInfo                                    `protobuf:"bytes,7,opt,name=lobbyInfo,proto3,oneof" json:"lobbyInfo,omitempty"`
+}
+
+func (x *ResponseLobbyChatUpdate) Reset() {
+	*x = ResponseLobbyChatUpdate{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_message_proto_msgTypes[61]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResponseLobbyChatUpdate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResponseLobbyChatUpdate) ProtoMessage() {}
+
+func (x *ResponseLobbyChatUpdate) ProtoReflect() protoreflect.Message {
+	mi := &file_message_proto_msgTypes[61]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResponseLobbyChatUpdate.ProtoReflect.Descriptor instead.
+func (*ResponseLobbyChatUpdate) Descriptor() ([]byte, []int) {
+	return file_message_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *ResponseLobbyChatUpdate) GetSteamIdLobby() uint64 {
+	if x != nil {
+		return x.SteamIdLobby
+	}
+	return 0
+}
+
+func (x *ResponseLobbyChatUpdate) GetSteamIdUserChanged() uint64 {
+	if x != nil {
+		return x.SteamIdUserChanged
+	}
+	return 0
+}
+
+func (x *ResponseLobbyChatUpdate) GetSteamIdUserChangedIsLobby() bool {
+	if x != nil {
+		return x.SteamIdUserChangedIsLobby
+	}
+	return false
+}
+
+func (x *ResponseLobbyChatUpdate) GetSteamIdMakingChange() uint64 {
+	if x != nil {
+		return x.SteamIdMakingChange
+	}
+	return 0
+}
+
+func (x *ResponseLobbyChatUpdate) GetSteamIdMakingChangeIsLobby() bool {
+	if x != nil {
+		return x.SteamIdMakingChangeIsLobby
+	}
+	return false
+}
+
+func (x *ResponseLobbyChatUpdate) GetChatMemberStateChange() ResponseLobbyChatUpdate_ChatMemberStateChange {
+	if x != nil {
+		return x.ChatMemberStateChange
+	}
+	return ResponseLobbyChatUpdate_None
+}
+
+func (x *ResponseLobbyChatUpdate) GetLobbyInfo() *LobbyInfo {
+	if x != nil {
+		return x.LobbyInfo
+	}
+	return nil
+}
+
+type ResponseLobbyJoin struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Locked                bool       `protobuf:"varint,1,opt,name=locked,proto3" json:"locked,omitempty"`
+	ChatRoomEnterResponse uint32     `protobuf:"varint,2,opt,name=ChatRoomEnterResponse,proto3" json:"ChatRoomEnterResponse,omitempty"`
+	ChatPermissions       uint32     `protobuf:"varint,3,opt,name=ChatPermissions,proto3" json:"ChatPermissions,omitempty"`
+	LobbyId               uint32     `protobuf:"varint,4,opt,name=lobbyId,proto3" json:"lobbyId,omitempty"`
+	Info                  *LobbyInfo `protobuf:"bytes,5,opt,name=info,proto3" json:"info,omitempty"`
+}
+
+func (x *ResponseLobbyJoin) Reset() {
+	*x = ResponseLobbyJoin{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_message_proto_msgTypes[62]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResponseLobbyJoin) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResponseLobbyJoin) ProtoMessage() {}
+
+func (x *ResponseLobbyJoin) ProtoReflect() protoreflect.Message {
+	mi := &file_message_proto_msgTypes[62]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResponseLobbyJoin.ProtoReflect.Descriptor instead.
+func (*ResponseLobbyJoin) Descriptor() ([]byte, []int) {
+	return file_message_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *ResponseLobbyJoin) GetLocked() bool {
+	if x != nil {
+		return x.Locked
+	}
+	return false
+}
+
+func (x *ResponseLobbyJoin) GetChatRoomEnterResponse() uint32 {
+	if x != nil {
+		return x.ChatRoomEnterResponse
+	}
+	return 0
+}
+
+func (x *ResponseLobbyJoin) GetChatPermissions() uint32 {
+	if x != nil {
+		return x.ChatPermissions
+	}
+	return 0
+}
+
+func (x *ResponseLobbyJoin) GetLobbyId() uint32 {
+	if x != nil {
+		return x.LobbyId
+	}
+	return 0
+}
+
+func (x *ResponseLobbyJoin) GetInfo() *LobbyInfo {
+	if x != nil {
+		return x.Info
+	}
+	return nil
+}
+
+type ResponseHasNewP2PPackage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SteamIDSource uint64 `protobuf:"varint,1,opt,name=steamIDSource,proto3" json:"steamIDSource,omitempty"`
+	DataSize      uint32 `protobuf:"varint,2,opt,name=dataSize,proto3" json:"dataSize,omitempty"`
+	Channel       int32  `protobuf:"varint,3,opt,name=channel,proto3" json:"channel,omitempty"`
+}
+
+func (x *ResponseHasNewP2PPackage) Reset() {
+	*x = ResponseHasNewP2PPackage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_message_proto_msgTypes[63]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResponseHasNewP2PPackage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResponseHasNewP2PPackage) ProtoMessage() {}
+
+func (x *ResponseHasNewP2PPackage) ProtoReflect() protoreflect.Message {
+	mi := &file_message_proto_msgTypes[63]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResponseHasNewP2PPackage.ProtoReflect.Descriptor instead.
+func (*ResponseHasNewP2PPackage) Descriptor() ([]byte, []int) {
+	return file_message_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *ResponseHasNewP2PPackage) GetSteamIDSource() uint64 {
+	if x != nil {
+		return x.SteamIDSource
+	}
+	return 0
+}
+
+func (x *ResponseHasNewP2PPackage) GetDataSize() uint32 {
+	if x != nil {
+		return x.DataSize
+	}
+	return 0
+}
+
+func (x *ResponseHasNewP2PPackage) GetChannel() int32 {
+	if x != nil {
+		return x.Channel
+	}
+	return 0
+}
+
+type ResponseP2PSessionArrive struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SteamIDSource uint64 `protobuf:"varint,1,opt,name=steamIDSource,proto3" json:"steamIDSource,omitempty"`
+}
+
+func (x *ResponseP2PSessionArrive) Reset() {
+	*x = ResponseP2PSessionArrive{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_message_proto_msgTypes[64]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResponseP2PSessionArrive) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResponseP2PSessionArrive) ProtoMessage() {}
+
+func (x *ResponseP2PSessionArrive) ProtoReflect() protoreflect.Message {
+	mi := &file_message_proto_msgTypes[64]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResponseP2PSessionArrive.ProtoReflect.Descriptor instead.
+func (*ResponseP2PSessionArrive) Descriptor() ([]byte, []int) {
+	return file_message_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *ResponseP2PSessionArrive) GetSteamIDSource() uint64 {
+	if x != nil {
+		return x.SteamIDSource
+	}
+	return 0
+}
+
+type ResponseServerPublicMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type    ResponseServerPublicMessage_PublicMessageType `protobuf:"varint,1,opt,name=type,proto3,enum=Paper.ResponseServerPublicMessage_PublicMessageType" json:"type,omitempty"`
+	Str     *string                                       `protobuf:"bytes,2,opt,name=str,proto3,oneof" json:"str,omitempty"`
+	Caption *string                                       `protobuf:"bytes,3,opt,name=caption,proto3,oneof" json:"caption,omitempty"`
+}
+
+func (x *ResponseServerPublicMessage) Reset() {
+	*x = ResponseServerPublicMessage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_message_proto_msgTypes[65]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResponseServerPublicMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResponseServerPublicMessage) ProtoMessage() {}
+
+func (x *ResponseServerPublicMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_message_proto_msgTypes[65]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResponseServerPublicMessage.ProtoReflect.Descriptor instead.
+func (*ResponseServerPublicMessage) Descriptor() ([]byte, []int) {
+	return file_message_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *ResponseServerPublicMessage) GetType() ResponseServerPublicMessage_PublicMessageType {
+	if x != nil {
+		return x.Type
+	}
+	return ResponseServerPublicMessage_DisplayStringAndContinue
+}
+
+func (x *ResponseServerPublicMessage) GetStr() string {
+	if x != nil && x.Str != nil {
+		return *x.Str
+	}
+	return ""
+}
+
+func (x *ResponseServerPublicMessage) GetCaption() string {
+	if x != nil && x.Caption != nil {
+		return *x.Caption
+	}
+	return ""
+}
+
+type ResponseServerUdpToken struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Token string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+}
+
+func (x *ResponseServerUdpToken) Reset() {
+	*x = ResponseServerUdpToken{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_message_proto_msgTypes[66]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResponseServerUdpToken) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResponseServerUdpToken) ProtoMessage() {}
+
+func (x *ResponseServerUdpToken) ProtoReflect() protoreflect.Message {
+	mi := &file_message_proto_msgTypes[66]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResponseServerUdpToken.ProtoReflect.Descriptor instead.
+func (*ResponseServerUdpToken) Descriptor() ([]byte, []int) {
+	return file_message_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *ResponseServerUdpToken) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type ResponseLogConsoleChat struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Steamid int64  `protobuf:"varint,1,opt,name=steamid,proto3" json:"steamid,omitempty"`
+	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *ResponseLogConsoleChat) Reset() {
+	*x = ResponseLogConsoleChat{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_message_proto_msgTypes[67]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResponseLogConsoleChat) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResponseLogConsoleChat) ProtoMessage() {}
+
+func (x *ResponseLogConsoleChat) ProtoReflect() protoreflect.Message {
+	mi := &file_message_proto_msgTypes[67]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResponseLogConsoleChat.ProtoReflect.Descriptor instead.
+func (*ResponseLogConsoleChat) Descriptor() ([]byte, []int) {
+	return file_message_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *ResponseLogConsoleChat) GetSteamid() int64 {
+	if x != nil {
+		return x.Steamid
+	}
+	return 0
+}
+
+func (x *ResponseLogConsoleChat) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type ResponseGlobalChat struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Steamid int64  `protobuf:"varint,1,opt,name=steamid,proto3" json:"steamid,omitempty"`
+	Name    string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Message string `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *ResponseGlobalChat) Reset() {
+	*x = ResponseGlobalChat{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_message_proto_msgTypes[68]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResponseGlobalChat) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResponseGlobalChat) ProtoMessage() {}
+
+func (x *ResponseGlobalChat) ProtoReflect() protoreflect.Message {
+	mi := &file_message_proto_msgTypes[68]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResponseGlobalChat.ProtoReflect.Descriptor instead.
+func (*ResponseGlobalChat) Descriptor() ([]byte, []int) {
+	return file_message_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *ResponseGlobalChat) GetSteamid() int64 {
+	if x != nil {
+		return x.Steamid
+	}
+	return 0
+}
+
+func (x *ResponseGlobalChat) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ResponseGlobalChat) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type ResponseGlobalChatStatus struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Joined          bool   `protobuf:"varint,1,opt,name=joined,proto3" json:"joined,omitempty"`
+	Enabled         bool   `protobuf:"varint,2,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	SlowModeSeconds uint32 `protobuf:"varint,3,opt,name=slowModeSeconds,proto3" json:"slowModeSeconds,omitempty"`
+}
+
+func (x *ResponseGlobalChatStatus) Reset() {
+	*x = ResponseGlobalChatStatus{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_message_proto_msgTypes[69]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResponseGlobalChatStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResponseGlobalChatStatus) ProtoMessage() {}
+
+func (x *ResponseGlobalChatStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_message_proto_msgTypes[69]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResponseGlobalChatStatus.ProtoReflect.Descriptor instead.
+func (*ResponseGlobalChatStatus) Descriptor() ([]byte, []int) {
+	return file_message_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *ResponseGlobalChatStatus) GetJoined() bool {
+	if x != nil {
+		return x.Joined
+	}
+	return false
+}
+
+func (x *ResponseGlobalChatStatus) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *ResponseGlobalChatStatus) GetSlowModeSeconds() uint32 {
+	if x != nil {
+		return x.SlowModeSeconds
+	}
+	return 0
+}
+
+// both the target and the sender receive this, the sender can check the result
+type ResponseWhisper struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SteamidFrom int64                         `protobuf:"varint,1,opt,name=steamidFrom,proto3" json:"steamidFrom,omitempty"`
+	Name        string                        `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	SteamIdTo   uint64                        `protobuf:"varint,3,opt,name=steamIdTo,proto3" json:"steamIdTo,omitempty"`
+	Message     string                        `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
+	Result      ResponseWhisper_WhisperResult `protobuf:"varint,5,opt,name=result,proto3,enum=Paper.ResponseWhisper_WhisperResult" json:"result,omitempty"`
+}
+
+func (x *ResponseWhisper) Reset() {
+	*x = ResponseWhisper{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_message_proto_msgTypes[70]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResponseWhisper) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResponseWhisper) ProtoMessage() {}
+
+func (x *ResponseWhisper) ProtoReflect() protoreflect.Message {
+	mi := &file_message_proto_msgTypes[70]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResponseWhisper.ProtoReflect.Descriptor instead.
+func (*ResponseWhisper) Descriptor() ([]byte, []int) {
+	return file_message_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *ResponseWhisper) GetSteamidFrom() int64 {
+	if x != nil {
+		return x.SteamidFrom
+	}
+	return 0
+}
+
+func (x *ResponseWhisper) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ResponseWhisper) GetSteamIdTo() uint64 {
+	if x != nil {
+		return x.SteamIdTo
+	}
+	return 0
+}
+
+func (x *ResponseWhisper) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ResponseWhisper) GetResult() ResponseWhisper_WhisperResult {
+	if x != nil {
+		return x.Result
+	}
+	return ResponseWhisper_Delivered
+}
+
+type UserInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId   int32 `protobuf:"varint,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	UserName int32 `protobuf:"varint,2,opt,name=userName,proto3" json:"userName,omitempty"`
+}
+
+func (x *UserInfo) Reset() {
+	*x = UserInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_message_proto_msgTypes[71]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UserInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserInfo) ProtoMessage() {}
+
+func (x *UserInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_message_proto_msgTypes[71]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserInfo.ProtoReflect.Descriptor instead.
+func (*UserInfo) Descriptor() ([]byte, []int) {
+	return file_message_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *UserInfo) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
 	}
 	return 0
 }
@@ -2471,7 +5887,7 @@ var file_message_proto_rawDesc = []byte{
 	0x05, 0x50, 0x61, 0x70, 0x65, 0x72, 0x22, 0x28, 0x0a, 0x0c, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x56,
 	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
 	0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
-	0x22, 0xa7, 0x03, 0x0a, 0x0d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x65, 0x61, 0x64,
+	0x22, 0x94, 0x06, 0x0a, 0x0d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x65, 0x61, 0x64,
 	0x65, 0x72, 0x12, 0x3b, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e,
 	0x32, 0x27, 0x2e, 0x50, 0x61, 0x70, 0x65, 0x72, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
 	0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x4d, 0x65,
@@ -2479,7 +5895,7 @@ var file_message_proto_rawDesc = []byte{
 	0x16, 0x0a, 0x06, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52,
 	0x06, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x12, 0x1c, 0x0a, 0x09, 0x68, 0x6f, 0x6c, 0x64, 0x56,
 	0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x68, 0x6f, 0x6c, 0x64,
-	0x56, 0x61, 0x6c, 0x75, 0x65, 0x22, 0xa2, 0x02, 0x0a, 0x12, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x56, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x8f, 0x05, 0x0a, 0x12, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
 	0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x08, 0x0a, 0x04,
 	0x54, 0x69, 0x6d, 0x65, 0x10, 0x00, 0x12, 0x0d, 0x0a, 0x09, 0x4c, 0x6f, 0x62, 0x62, 0x79, 0x4c,
 	0x69, 0x73, 0x74, 0x10, 0x01, 0x12, 0x0f, 0x0a, 0x0b, 0x4c, 0x6f, 0x62, 0x62, 0x79, 0x43, 0x72,
@@ -2497,296 +5913,760 @@ var file_message_proto_rawDesc = []byte{
 	0x0e, 0x0a, 0x0a, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x10, 0x0c, 0x12,
 	0x15, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x55, 0x64, 0x70, 0x54,
 	0x6f, 0x6b, 0x65, 0x6e, 0x10, 0x0d, 0x12, 0x12, 0x0a, 0x0e, 0x4c, 0x6f, 0x67, 0x43, 0x6f, 0x6e,
-	0x73, 0x6f, 0x6c, 0x65, 0x43, 0x68, 0x61, 0x74, 0x10, 0x0e, 0x22, 0x32, 0x0a, 0x10, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x4c, 0x6f, 0x62, 0x62, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x1e,
-	0x0a, 0x0a, 0x6c, 0x6f, 0x62, 0x62, 0x79, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x05, 0x52, 0x0a, 0x6c, 0x6f, 0x62, 0x62, 0x79, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x22, 0xd0,
-	0x01, 0x0a, 0x0c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x12,
-	0x20, 0x0a, 0x0b, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x56, 0x65, 0x72, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x56, 0x65,
-	0x72, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x74, 0x65, 0x61, 0x6d, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x04, 0x52, 0x07, 0x73, 0x74, 0x65, 0x61, 0x6d, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x6e,
-	0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
-	0x34, 0x0a, 0x08, 0x6c, 0x61, 0x6e, 0x67, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x0e, 0x32, 0x18, 0x2e, 0x50, 0x61, 0x70, 0x65, 0x72, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x2e, 0x4c, 0x61, 0x6e, 0x67, 0x52, 0x08, 0x6c, 0x61, 0x6e,
-	0x67, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x67, 0x61, 0x6d, 0x65, 0x49, 0x6d, 0x61,
-	0x67, 0x65, 0x43, 0x72, 0x63, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x67, 0x61, 0x6d,
-	0x65, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x43, 0x72, 0x63, 0x22, 0x16, 0x0a, 0x04, 0x4c, 0x61, 0x6e,
-	0x67, 0x12, 0x06, 0x0a, 0x02, 0x45, 0x4e, 0x10, 0x00, 0x12, 0x06, 0x0a, 0x02, 0x5a, 0x48, 0x10,
-	0x01, 0x22, 0x74, 0x0a, 0x12, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x4c, 0x6f, 0x62, 0x62,
-	0x79, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x08, 0x70,
-	0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52,
-	0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x88, 0x01, 0x01, 0x12, 0x1c, 0x0a, 0x09,
-	0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x50, 0x32, 0x50, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x09, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x50, 0x32, 0x50, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x70,
-	0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x22, 0x63, 0x0a, 0x13, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x53, 0x65, 0x74, 0x4c, 0x6f, 0x62, 0x62, 0x79, 0x44, 0x61, 0x74, 0x61, 0x12, 0x18,
-	0x0a, 0x07, 0x6c, 0x6f, 0x62, 0x62, 0x79, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
-	0x07, 0x6c, 0x6f, 0x62, 0x62, 0x79, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x63, 0x68, 0x4b,
-	0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x63, 0x68, 0x4b, 0x65, 0x79,
-	0x12, 0x1a, 0x0a, 0x08, 0x70, 0x63, 0x68, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x08, 0x70, 0x63, 0x68, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x69, 0x0a, 0x19,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x53, 0x65, 0x74, 0x4c, 0x6f, 0x62, 0x62, 0x79, 0x4d,
-	0x65, 0x6d, 0x62, 0x65, 0x72, 0x44, 0x61, 0x74, 0x61, 0x12, 0x18, 0x0a, 0x07, 0x6c, 0x6f, 0x62,
+	0x73, 0x6f, 0x6c, 0x65, 0x43, 0x68, 0x61, 0x74, 0x10, 0x0e, 0x12, 0x12, 0x0a, 0x0e, 0x47, 0x6c,
+	0x6f, 0x62, 0x61, 0x6c, 0x43, 0x68, 0x61, 0x74, 0x4a, 0x6f, 0x69, 0x6e, 0x10, 0x0f, 0x12, 0x0e,
+	0x0a, 0x0a, 0x47, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x43, 0x68, 0x61, 0x74, 0x10, 0x10, 0x12, 0x0b,
+	0x0a, 0x07, 0x57, 0x68, 0x69, 0x73, 0x70, 0x65, 0x72, 0x10, 0x11, 0x12, 0x0d, 0x0a, 0x09, 0x4c,
+	0x6f, 0x62, 0x62, 0x79, 0x4b, 0x69, 0x63, 0x6b, 0x10, 0x12, 0x12, 0x0c, 0x0a, 0x08, 0x4c, 0x6f,
+	0x62, 0x62, 0x79, 0x42, 0x61, 0x6e, 0x10, 0x13, 0x12, 0x14, 0x0a, 0x10, 0x4c, 0x6f, 0x62, 0x62,
+	0x79, 0x53, 0x65, 0x74, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x10, 0x14, 0x12, 0x14,
+	0x0a, 0x10, 0x4c, 0x6f, 0x62, 0x62, 0x79, 0x53, 0x65, 0x74, 0x4a, 0x6f, 0x69, 0x6e, 0x61, 0x62,
+	0x6c, 0x65, 0x10, 0x15, 0x12, 0x16, 0x0a, 0x12, 0x4c, 0x6f, 0x62, 0x62, 0x79, 0x54, 0x72, 0x61,
+	0x6e, 0x73, 0x66, 0x65, 0x72, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x10, 0x16, 0x12, 0x16, 0x0a, 0x12,
+	0x4c, 0x6f, 0x62, 0x62, 0x79, 0x53, 0x65, 0x74, 0x53, 0x70, 0x65, 0x63, 0x74, 0x61, 0x74, 0x6f,
+	0x72, 0x73, 0x10, 0x17, 0x12, 0x16, 0x0a, 0x12, 0x4c, 0x6f, 0x62, 0x62, 0x79, 0x4c, 0x69, 0x73,
+	0x74, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x10, 0x18, 0x12, 0x0d, 0x0a, 0x09,
+	0x4d, 0x61, 0x74, 0x63, 0x68, 0x6d, 0x61, 0x6b, 0x65, 0x10, 0x19, 0x12, 0x13, 0x0a, 0x0f, 0x4c,
+	0x6f, 0x62, 0x62, 0x79, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x10, 0x1a,
+	0x12, 0x13, 0x0a, 0x0f, 0x4a, 0x6f, 0x69, 0x6e, 0x4c, 0x6f, 0x62, 0x62, 0x79, 0x42, 0x79, 0x43,
+	0x6f, 0x64, 0x65, 0x10, 0x1b, 0x12, 0x13, 0x0a, 0x0f, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4c,
+	0x6f, 0x62, 0x62, 0x79, 0x44, 0x61, 0x74, 0x61, 0x10, 0x1c, 0x12, 0x13, 0x0a, 0x0f, 0x4c, 0x6f,
+	0x62, 0x62, 0x79, 0x41, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x4a, 0x6f, 0x69, 0x6e, 0x10, 0x1d, 0x12,
+	0x0c, 0x0a, 0x08, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x10, 0x1e, 0x12, 0x11, 0x0a,
+	0x0d, 0x50, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x63, 0x65, 0x51, 0x75, 0x65, 0x72, 0x79, 0x10, 0x1f,
+	0x12, 0x15, 0x0a, 0x11, 0x50, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x63, 0x65, 0x53, 0x75, 0x62, 0x73,
+	0x63, 0x72, 0x69, 0x62, 0x65, 0x10, 0x20, 0x12, 0x0e, 0x0a, 0x0a, 0x4a, 0x6f, 0x69, 0x6e, 0x50,
+	0x6c, 0x61, 0x79, 0x65, 0x72, 0x10, 0x21, 0x22, 0xe9, 0x02, 0x0a, 0x10, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x4c, 0x6f, 0x62, 0x62, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a,
+	0x6c, 0x6f, 0x62, 0x62, 0x79, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x0a, 0x6c, 0x6f, 0x62, 0x62, 0x79, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x30, 0x0a, 0x07,
+	0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e,
+	0x50, 0x61, 0x70, 0x65, 0x72, 0x2e, 0x4c, 0x6f, 0x62, 0x62, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x46,
+	0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x07, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x12, 0x1a,
+	0x0a, 0x08, 0x68, 0x69, 0x64, 0x65, 0x46, 0x75, 0x6c, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x08, 0x68, 0x69, 0x64, 0x65, 0x46, 0x75, 0x6c, 0x6c, 0x12, 0x1e, 0x0a, 0x0a, 0x68, 0x69,
+	0x64, 0x65, 0x4c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a,
+	0x68, 0x69, 0x64, 0x65, 0x4c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x12, 0x22, 0x0a, 0x0c, 0x6e, 0x61,
+	0x6d, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0c, 0x6e, 0x61, 0x6d, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x73, 0x12, 0x39,
+	0x0a, 0x04, 0x73, 0x6f, 0x72, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x25, 0x2e, 0x50,
+	0x61, 0x70, 0x65, 0x72, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x4c, 0x6f, 0x62, 0x62,
+	0x79, 0x4c, 0x69, 0x73, 0x74, 0x2e, 0x4c, 0x6f, 0x62, 0x62, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x53,
+	0x6f, 0x72, 0x74, 0x52, 0x04, 0x73, 0x6f, 0x72, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x67,
+	0x65, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x70, 0x61, 0x67,
+	0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x22, 0x34, 0x0a,
+	0x0d, 0x4c, 0x6f, 0x62, 0x62, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x6f, 0x72, 0x74, 0x12, 0x08,
+	0x0a, 0x04, 0x42, 0x79, 0x49, 0x64, 0x10, 0x00, 0x12, 0x0d, 0x0a, 0x09, 0x42, 0x79, 0x4d, 0x65,
+	0x6d, 0x62, 0x65, 0x72, 0x73, 0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06, 0x42, 0x79, 0x4e, 0x61, 0x6d,
+	0x65, 0x10, 0x02, 0x22, 0x59, 0x0a, 0x19, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x4c, 0x6f,
+	0x62, 0x62, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65,
+	0x12, 0x1c, 0x0a, 0x09, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x09, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x12, 0x1e,
+	0x0a, 0x0a, 0x6c, 0x6f, 0x62, 0x62, 0x79, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x0a, 0x6c, 0x6f, 0x62, 0x62, 0x79, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x22, 0xf0,
+	0x01, 0x0a, 0x10, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x6d,
+	0x61, 0x6b, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x06, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x12, 0x1e, 0x0a, 0x0a, 0x6c,
+	0x6f, 0x62, 0x62, 0x79, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x0a, 0x6c, 0x6f, 0x62, 0x62, 0x79, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x36, 0x0a, 0x08, 0x6d,
+	0x6f, 0x64, 0x65, 0x4b, 0x65, 0x79, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x50, 0x61, 0x70, 0x65, 0x72, 0x2e, 0x4c, 0x6f, 0x62, 0x62, 0x79, 0x44, 0x61, 0x74, 0x61, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x08, 0x6d, 0x6f, 0x64, 0x65, 0x4b,
+	0x65, 0x79, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x70, 0x72, 0x65, 0x66, 0x65, 0x72, 0x72, 0x65, 0x64,
+	0x53, 0x69, 0x7a, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x70, 0x72, 0x65, 0x66,
+	0x65, 0x72, 0x72, 0x65, 0x64, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x2c, 0x0a, 0x04, 0x6c, 0x61, 0x6e,
+	0x67, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x50, 0x61, 0x70, 0x65, 0x72, 0x2e,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x2e, 0x4c, 0x61, 0x6e,
+	0x67, 0x52, 0x04, 0x6c, 0x61, 0x6e, 0x67, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x6e, 0x79, 0x4c, 0x61,
+	0x6e, 0x67, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x61, 0x6e, 0x79, 0x4c, 0x61, 0x6e,
+	0x67, 0x22, 0x9f, 0x01, 0x0a, 0x16, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x4c, 0x6f, 0x62,
+	0x62, 0x79, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x18, 0x0a, 0x07,
+	0x6c, 0x6f, 0x62, 0x62, 0x79, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x6c,
+	0x6f, 0x62, 0x62, 0x79, 0x49, 0x64, 0x12, 0x3c, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x24, 0x2e, 0x50, 0x61, 0x70, 0x65, 0x72, 0x2e, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x4c, 0x6f, 0x62, 0x62, 0x79, 0x49, 0x6e, 0x76, 0x69, 0x74,
+	0x65, 0x43, 0x6f, 0x64, 0x65, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x06, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x22, 0x2d, 0x0a, 0x06, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x07,
+	0x0a, 0x03, 0x47, 0x65, 0x74, 0x10, 0x00, 0x12, 0x0e, 0x0a, 0x0a, 0x52, 0x65, 0x67, 0x65, 0x6e,
+	0x65, 0x72, 0x61, 0x74, 0x65, 0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06, 0x52, 0x65, 0x76, 0x6f, 0x6b,
+	0x65, 0x10, 0x02, 0x22, 0x48, 0x0a, 0x16, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x4a, 0x6f,
+	0x69, 0x6e, 0x4c, 0x6f, 0x62, 0x62, 0x79, 0x42, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x12, 0x0a,
+	0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x6f, 0x64,
+	0x65, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x70, 0x65, 0x63, 0x74, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x08, 0x73, 0x70, 0x65, 0x63, 0x74, 0x61, 0x74, 0x65, 0x22, 0xc8, 0x01,
+	0x0a, 0x16, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4c,
+	0x6f, 0x62, 0x62, 0x79, 0x44, 0x61, 0x74, 0x61, 0x12, 0x18, 0x0a, 0x07, 0x6c, 0x6f, 0x62, 0x62,
+	0x79, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x6c, 0x6f, 0x62, 0x62, 0x79,
+	0x49, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x44, 0x61, 0x74, 0x61,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x44, 0x61,
+	0x74, 0x61, 0x12, 0x2c, 0x0a, 0x03, 0x73, 0x65, 0x74, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x50, 0x61, 0x70, 0x65, 0x72, 0x2e, 0x4c, 0x6f, 0x62, 0x62, 0x79, 0x44, 0x61, 0x74,
+	0x61, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x03, 0x73, 0x65, 0x74,
+	0x12, 0x16, 0x0a, 0x06, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x06, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x12, 0x2e, 0x0a, 0x06, 0x65, 0x78, 0x70, 0x65,
+	0x63, 0x74, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x50, 0x61, 0x70, 0x65, 0x72,
+	0x2e, 0x4c, 0x6f, 0x62, 0x62, 0x79, 0x44, 0x61, 0x74, 0x61, 0x45, 0x78, 0x70, 0x65, 0x63, 0x74,
+	0x52, 0x06, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x22, 0x45, 0x0a, 0x0f, 0x4c, 0x6f, 0x62, 0x62,
+	0x79, 0x44, 0x61, 0x74, 0x61, 0x45, 0x78, 0x70, 0x65, 0x63, 0x74, 0x12, 0x0c, 0x0a, 0x01, 0x6b,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x01, 0x6b, 0x12, 0x0c, 0x0a, 0x01, 0x76, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x01, 0x76, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x62, 0x73, 0x65, 0x6e,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x61, 0x62, 0x73, 0x65, 0x6e, 0x74, 0x22,
+	0x64, 0x0a, 0x16, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x4c, 0x6f, 0x62, 0x62, 0x79, 0x41,
+	0x6e, 0x73, 0x77, 0x65, 0x72, 0x4a, 0x6f, 0x69, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x6c, 0x6f, 0x62,
+	0x62, 0x79, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x6c, 0x6f, 0x62, 0x62,
+	0x79, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x74, 0x65, 0x61, 0x6d, 0x49, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x73, 0x74, 0x65, 0x61, 0x6d, 0x49, 0x64, 0x12, 0x16, 0x0a,
+	0x06, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x61,
+	0x63, 0x63, 0x65, 0x70, 0x74, 0x22, 0xdb, 0x01, 0x0a, 0x0f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x35, 0x0a, 0x06, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1d, 0x2e, 0x50, 0x61, 0x70, 0x65,
+	0x72, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x2f, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b,
+	0x2e, 0x50, 0x61, 0x70, 0x65, 0x72, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65,
+	0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x4b, 0x69, 0x6e, 0x64, 0x52, 0x04, 0x6b, 0x69, 0x6e,
+	0x64, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x74, 0x65, 0x61, 0x6d, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x07, 0x73, 0x74, 0x65, 0x61, 0x6d, 0x49, 0x64, 0x22, 0x27, 0x0a, 0x06, 0x41,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x08, 0x0a, 0x04, 0x4c, 0x69, 0x73, 0x74, 0x10, 0x00, 0x12,
+	0x07, 0x0a, 0x03, 0x41, 0x64, 0x64, 0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06, 0x52, 0x65, 0x6d, 0x6f,
+	0x76, 0x65, 0x10, 0x02, 0x22, 0x1d, 0x0a, 0x04, 0x4b, 0x69, 0x6e, 0x64, 0x12, 0x0a, 0x0a, 0x06,
+	0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x10, 0x00, 0x12, 0x09, 0x0a, 0x05, 0x42, 0x6c, 0x6f, 0x63,
+	0x6b, 0x10, 0x01, 0x22, 0x32, 0x0a, 0x14, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x50, 0x72,
+	0x65, 0x73, 0x65, 0x6e, 0x63, 0x65, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x73,
+	0x74, 0x65, 0x61, 0x6d, 0x49, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x04, 0x52, 0x08, 0x73,
+	0x74, 0x65, 0x61, 0x6d, 0x49, 0x64, 0x73, 0x22, 0x38, 0x0a, 0x18, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x50, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x63, 0x65, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72,
+	0x69, 0x62, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62,
+	0x65, 0x22, 0x77, 0x0a, 0x11, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x4a, 0x6f, 0x69, 0x6e,
+	0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x74, 0x65, 0x61, 0x6d, 0x49,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x73, 0x74, 0x65, 0x61, 0x6d, 0x49, 0x64,
+	0x12, 0x1f, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x48, 0x00, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x88, 0x01,
+	0x01, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x70, 0x65, 0x63, 0x74, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x08, 0x73, 0x70, 0x65, 0x63, 0x74, 0x61, 0x74, 0x65, 0x42, 0x0b, 0x0a,
+	0x09, 0x5f, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x22, 0x89, 0x02, 0x0a, 0x0f, 0x4c,
+	0x6f, 0x62, 0x62, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x10,
+	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79,
+	0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x41, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x72,
+	0x69, 0x73, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x21, 0x2e, 0x50, 0x61, 0x70,
+	0x65, 0x72, 0x2e, 0x4c, 0x6f, 0x62, 0x62, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x69, 0x6c, 0x74,
+	0x65, 0x72, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x69, 0x73, 0x6f, 0x6e, 0x52, 0x0a, 0x63,
+	0x6f, 0x6d, 0x70, 0x61, 0x72, 0x69, 0x73, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x6e, 0x75, 0x6d,
+	0x65, 0x72, 0x69, 0x63, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x6e, 0x75, 0x6d, 0x65,
+	0x72, 0x69, 0x63, 0x22, 0x71, 0x0a, 0x0a, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x69, 0x73, 0x6f,
+	0x6e, 0x12, 0x09, 0x0a, 0x05, 0x45, 0x71, 0x75, 0x61, 0x6c, 0x10, 0x00, 0x12, 0x0c, 0x0a, 0x08,
+	0x4e, 0x6f, 0x74, 0x45, 0x71, 0x75, 0x61, 0x6c, 0x10, 0x01, 0x12, 0x0c, 0x0a, 0x08, 0x4c, 0x65,
+	0x73, 0x73, 0x54, 0x68, 0x61, 0x6e, 0x10, 0x02, 0x12, 0x13, 0x0a, 0x0f, 0x4c, 0x65, 0x73, 0x73,
+	0x54, 0x68, 0x61, 0x6e, 0x4f, 0x72, 0x45, 0x71, 0x75, 0x61, 0x6c, 0x10, 0x03, 0x12, 0x0f, 0x0a,
+	0x0b, 0x47, 0x72, 0x65, 0x61, 0x74, 0x65, 0x72, 0x54, 0x68, 0x61, 0x6e, 0x10, 0x04, 0x12, 0x16,
+	0x0a, 0x12, 0x47, 0x72, 0x65, 0x61, 0x74, 0x65, 0x72, 0x54, 0x68, 0x61, 0x6e, 0x4f, 0x72, 0x45,
+	0x71, 0x75, 0x61, 0x6c, 0x10, 0x05, 0x22, 0xa4, 0x02, 0x0a, 0x0c, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x63, 0x6f, 0x6c, 0x56, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x56, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x74, 0x65,
+	0x61, 0x6d, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x73, 0x74, 0x65, 0x61,
+	0x6d, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x34, 0x0a, 0x08, 0x6c, 0x61, 0x6e, 0x67, 0x43,
+	0x6f, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x50, 0x61, 0x70, 0x65,
+	0x72, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x2e, 0x4c,
+	0x61, 0x6e, 0x67, 0x52, 0x08, 0x6c, 0x61, 0x6e, 0x67, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x22, 0x0a,
+	0x0c, 0x67, 0x61, 0x6d, 0x65, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x43, 0x72, 0x63, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x0c, 0x67, 0x61, 0x6d, 0x65, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x43, 0x72,
+	0x63, 0x12, 0x26, 0x0a, 0x0e, 0x6d, 0x61, 0x78, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x53,
+	0x69, 0x7a, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e, 0x6d, 0x61, 0x78, 0x4d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x2a, 0x0a, 0x10, 0x73, 0x75, 0x70,
+	0x70, 0x6f, 0x72, 0x74, 0x46, 0x72, 0x61, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x10, 0x73, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x46, 0x72, 0x61, 0x67,
+	0x6d, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x16, 0x0a, 0x04, 0x4c, 0x61, 0x6e, 0x67, 0x12, 0x06, 0x0a,
+	0x02, 0x45, 0x4e, 0x10, 0x00, 0x12, 0x06, 0x0a, 0x02, 0x5a, 0x48, 0x10, 0x01, 0x22, 0xb2, 0x02,
+	0x0a, 0x12, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x4c, 0x6f, 0x62, 0x62, 0x79, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73,
+	0x77, 0x6f, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x08, 0x70, 0x61,
+	0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x88, 0x01, 0x01, 0x12, 0x1c, 0x0a, 0x09, 0x65, 0x6e, 0x61,
+	0x62, 0x6c, 0x65, 0x50, 0x32, 0x50, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x65, 0x6e,
+	0x61, 0x62, 0x6c, 0x65, 0x50, 0x32, 0x50, 0x12, 0x1e, 0x0a, 0x0a, 0x6d, 0x61, 0x78, 0x4d, 0x65,
+	0x6d, 0x62, 0x65, 0x72, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x6d, 0x61, 0x78,
+	0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x6c, 0x6f, 0x62, 0x62, 0x79,
+	0x47, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x6c, 0x6f, 0x62,
+	0x62, 0x79, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x36, 0x0a, 0x0a, 0x76, 0x69, 0x73, 0x69, 0x62,
+	0x69, 0x6c, 0x69, 0x74, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x50, 0x61,
+	0x70, 0x65, 0x72, 0x2e, 0x4c, 0x6f, 0x62, 0x62, 0x79, 0x56, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c,
+	0x69, 0x74, 0x79, 0x52, 0x0a, 0x76, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x12,
+	0x28, 0x0a, 0x0f, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76,
+	0x61, 0x6c, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72,
+	0x65, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x65, 0x6d,
+	0x70, 0x6c, 0x61, 0x74, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x65, 0x6d,
+	0x70, 0x6c, 0x61, 0x74, 0x65, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f,
+	0x72, 0x64, 0x22, 0x63, 0x0a, 0x13, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x53, 0x65, 0x74,
+	0x4c, 0x6f, 0x62, 0x62, 0x79, 0x44, 0x61, 0x74, 0x61, 0x12, 0x18, 0x0a, 0x07, 0x6c, 0x6f, 0x62,
 	0x62, 0x79, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x6c, 0x6f, 0x62, 0x62,
 	0x79, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x63, 0x68, 0x4b, 0x65, 0x79, 0x18, 0x02, 0x20,
 	0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x63, 0x68, 0x4b, 0x65, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x70,
 	0x63, 0x68, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70,
-	0x63, 0x68, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x5a, 0x0a, 0x10, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x4a, 0x6f, 0x69, 0x6e, 0x4c, 0x6f, 0x62, 0x62, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x6c,
-	0x6f, 0x62, 0x62, 0x79, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x6c, 0x6f,
-	0x62, 0x62, 0x79, 0x49, 0x44, 0x12, 0x1f, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72,
-	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77,
-	0x6f, 0x72, 0x64, 0x88, 0x01, 0x01, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x70, 0x61, 0x73, 0x73, 0x77,
-	0x6f, 0x72, 0x64, 0x22, 0xca, 0x02, 0x0a, 0x15, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x53,
-	0x65, 0x6e, 0x64, 0x50, 0x32, 0x50, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x12, 0x24, 0x0a,
-	0x0d, 0x73, 0x74, 0x65, 0x61, 0x6d, 0x49, 0x44, 0x52, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x73, 0x74, 0x65, 0x61, 0x6d, 0x49, 0x44, 0x52, 0x65, 0x6d,
-	0x6f, 0x74, 0x65, 0x12, 0x2c, 0x0a, 0x11, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x69, 0x6e, 0x67,
-	0x44, 0x61, 0x74, 0x61, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x11,
-	0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x69, 0x6e, 0x67, 0x44, 0x61, 0x74, 0x61, 0x53, 0x69, 0x7a,
-	0x65, 0x12, 0x47, 0x0a, 0x0b, 0x70, 0x32, 0x70, 0x53, 0x65, 0x6e, 0x64, 0x54, 0x79, 0x70, 0x65,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x25, 0x2e, 0x50, 0x61, 0x70, 0x65, 0x72, 0x2e, 0x52,
+	0x63, 0x68, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x69, 0x0a, 0x19, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x53, 0x65, 0x74, 0x4c, 0x6f, 0x62, 0x62, 0x79, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72,
+	0x44, 0x61, 0x74, 0x61, 0x12, 0x18, 0x0a, 0x07, 0x6c, 0x6f, 0x62, 0x62, 0x79, 0x49, 0x44, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x6c, 0x6f, 0x62, 0x62, 0x79, 0x49, 0x44, 0x12, 0x16,
+	0x0a, 0x06, 0x70, 0x63, 0x68, 0x4b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x70, 0x63, 0x68, 0x4b, 0x65, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x63, 0x68, 0x56, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x63, 0x68, 0x56, 0x61, 0x6c,
+	0x75, 0x65, 0x22, 0x76, 0x0a, 0x10, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x4a, 0x6f, 0x69,
+	0x6e, 0x4c, 0x6f, 0x62, 0x62, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x6c, 0x6f, 0x62, 0x62, 0x79, 0x49,
+	0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x6c, 0x6f, 0x62, 0x62, 0x79, 0x49, 0x44,
+	0x12, 0x1f, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x48, 0x00, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x88, 0x01,
+	0x01, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x70, 0x65, 0x63, 0x74, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x08, 0x73, 0x70, 0x65, 0x63, 0x74, 0x61, 0x74, 0x65, 0x42, 0x0b, 0x0a,
+	0x09, 0x5f, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x22, 0xca, 0x02, 0x0a, 0x15, 0x52,
 	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x53, 0x65, 0x6e, 0x64, 0x50, 0x32, 0x50, 0x50, 0x61, 0x63,
-	0x6b, 0x61, 0x67, 0x65, 0x2e, 0x45, 0x50, 0x32, 0x50, 0x53, 0x65, 0x6e, 0x64, 0x52, 0x0b, 0x70,
-	0x32, 0x70, 0x53, 0x65, 0x6e, 0x64, 0x54, 0x79, 0x70, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x68,
-	0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x63, 0x68, 0x61,
-	0x6e, 0x6e, 0x65, 0x6c, 0x22, 0x7a, 0x0a, 0x08, 0x45, 0x50, 0x32, 0x50, 0x53, 0x65, 0x6e, 0x64,
-	0x12, 0x16, 0x0a, 0x12, 0x45, 0x50, 0x32, 0x50, 0x53, 0x65, 0x6e, 0x64, 0x55, 0x6e, 0x72, 0x65,
-	0x6c, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x10, 0x00, 0x12, 0x1d, 0x0a, 0x19, 0x45, 0x50, 0x32, 0x50,
-	0x53, 0x65, 0x6e, 0x64, 0x55, 0x6e, 0x72, 0x65, 0x6c, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x4e, 0x6f,
-	0x44, 0x65, 0x6c, 0x61, 0x79, 0x10, 0x01, 0x12, 0x14, 0x0a, 0x10, 0x45, 0x50, 0x32, 0x50, 0x53,
-	0x65, 0x6e, 0x64, 0x52, 0x65, 0x6c, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x10, 0x02, 0x12, 0x21, 0x0a,
-	0x1d, 0x45, 0x50, 0x32, 0x50, 0x53, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x6c, 0x69, 0x61, 0x62, 0x6c,
-	0x65, 0x57, 0x69, 0x74, 0x68, 0x42, 0x75, 0x66, 0x66, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x10, 0x03,
-	0x22, 0x2d, 0x0a, 0x11, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x4c, 0x65, 0x61, 0x76, 0x65,
-	0x4c, 0x6f, 0x62, 0x62, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x6c, 0x6f, 0x62, 0x62, 0x79, 0x49, 0x44,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x6c, 0x6f, 0x62, 0x62, 0x79, 0x49, 0x44, 0x22,
-	0x31, 0x0a, 0x15, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x4c, 0x6f, 0x67, 0x43, 0x6f, 0x6e,
-	0x73, 0x6f, 0x6c, 0x65, 0x43, 0x68, 0x61, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73,
-	0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
-	0x67, 0x65, 0x22, 0x90, 0x04, 0x0a, 0x0e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48,
-	0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x3d, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0e, 0x32, 0x29, 0x2e, 0x50, 0x61, 0x70, 0x65, 0x72, 0x2e, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04,
-	0x74, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x12, 0x1c, 0x0a, 0x09,
-	0x68, 0x6f, 0x6c, 0x64, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52,
-	0x09, 0x68, 0x6f, 0x6c, 0x64, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x88, 0x03, 0x0a, 0x13, 0x52,
+	0x6b, 0x61, 0x67, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x73, 0x74, 0x65, 0x61, 0x6d, 0x49, 0x44, 0x52,
+	0x65, 0x6d, 0x6f, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x73, 0x74, 0x65,
+	0x61, 0x6d, 0x49, 0x44, 0x52, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x12, 0x2c, 0x0a, 0x11, 0x66, 0x6f,
+	0x6c, 0x6c, 0x6f, 0x77, 0x69, 0x6e, 0x67, 0x44, 0x61, 0x74, 0x61, 0x53, 0x69, 0x7a, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x11, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x69, 0x6e, 0x67,
+	0x44, 0x61, 0x74, 0x61, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x47, 0x0a, 0x0b, 0x70, 0x32, 0x70, 0x53,
+	0x65, 0x6e, 0x64, 0x54, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x25, 0x2e,
+	0x50, 0x61, 0x70, 0x65, 0x72, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x53, 0x65, 0x6e,
+	0x64, 0x50, 0x32, 0x50, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x2e, 0x45, 0x50, 0x32, 0x50,
+	0x53, 0x65, 0x6e, 0x64, 0x52, 0x0b, 0x70, 0x32, 0x70, 0x53, 0x65, 0x6e, 0x64, 0x54, 0x79, 0x70,
+	0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x22, 0x7a, 0x0a, 0x08, 0x45,
+	0x50, 0x32, 0x50, 0x53, 0x65, 0x6e, 0x64, 0x12, 0x16, 0x0a, 0x12, 0x45, 0x50, 0x32, 0x50, 0x53,
+	0x65, 0x6e, 0x64, 0x55, 0x6e, 0x72, 0x65, 0x6c, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x10, 0x00, 0x12,
+	0x1d, 0x0a, 0x19, 0x45, 0x50, 0x32, 0x50, 0x53, 0x65, 0x6e, 0x64, 0x55, 0x6e, 0x72, 0x65, 0x6c,
+	0x69, 0x61, 0x62, 0x6c, 0x65, 0x4e, 0x6f, 0x44, 0x65, 0x6c, 0x61, 0x79, 0x10, 0x01, 0x12, 0x14,
+	0x0a, 0x10, 0x45, 0x50, 0x32, 0x50, 0x53, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x6c, 0x69, 0x61, 0x62,
+	0x6c, 0x65, 0x10, 0x02, 0x12, 0x21, 0x0a, 0x1d, 0x45, 0x50, 0x32, 0x50, 0x53, 0x65, 0x6e, 0x64,
+	0x52, 0x65, 0x6c, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x57, 0x69, 0x74, 0x68, 0x42, 0x75, 0x66, 0x66,
+	0x65, 0x72, 0x69, 0x6e, 0x67, 0x10, 0x03, 0x22, 0x2d, 0x0a, 0x11, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x4c, 0x6f, 0x62, 0x62, 0x79, 0x12, 0x18, 0x0a, 0x07,
+	0x6c, 0x6f, 0x62, 0x62, 0x79, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x6c,
+	0x6f, 0x62, 0x62, 0x79, 0x49, 0x44, 0x22, 0x31, 0x0a, 0x15, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x4c, 0x6f, 0x67, 0x43, 0x6f, 0x6e, 0x73, 0x6f, 0x6c, 0x65, 0x43, 0x68, 0x61, 0x74, 0x12,
+	0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x2b, 0x0a, 0x15, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x47, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x43, 0x68, 0x61, 0x74, 0x4a, 0x6f,
+	0x69, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x6a, 0x6f, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x04, 0x6a, 0x6f, 0x69, 0x6e, 0x22, 0x2d, 0x0a, 0x11, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x47, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x43, 0x68, 0x61, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x50, 0x0a, 0x0e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x57, 0x68, 0x69, 0x73, 0x70, 0x65, 0x72, 0x12, 0x24, 0x0a, 0x0d, 0x73, 0x74, 0x65, 0x61, 0x6d,
+	0x49, 0x64, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d,
+	0x73, 0x74, 0x65, 0x61, 0x6d, 0x49, 0x64, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x18, 0x0a,
+	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x52, 0x0a, 0x10, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x4c, 0x6f, 0x62, 0x62, 0x79, 0x4b, 0x69, 0x63, 0x6b, 0x12, 0x18, 0x0a, 0x07, 0x6c,
+	0x6f, 0x62, 0x62, 0x79, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x6c, 0x6f,
+	0x62, 0x62, 0x79, 0x49, 0x44, 0x12, 0x24, 0x0a, 0x0d, 0x73, 0x74, 0x65, 0x61, 0x6d, 0x49, 0x64,
+	0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x73, 0x74,
+	0x65, 0x61, 0x6d, 0x49, 0x64, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x22, 0x67, 0x0a, 0x0f, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x4c, 0x6f, 0x62, 0x62, 0x79, 0x42, 0x61, 0x6e, 0x12, 0x18,
+	0x0a, 0x07, 0x6c, 0x6f, 0x62, 0x62, 0x79, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x07, 0x6c, 0x6f, 0x62, 0x62, 0x79, 0x49, 0x44, 0x12, 0x24, 0x0a, 0x0d, 0x73, 0x74, 0x65, 0x61,
+	0x6d, 0x49, 0x64, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x0d, 0x73, 0x74, 0x65, 0x61, 0x6d, 0x49, 0x64, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x14,
+	0x0a, 0x05, 0x75, 0x6e, 0x62, 0x61, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x75,
+	0x6e, 0x62, 0x61, 0x6e, 0x22, 0x61, 0x0a, 0x17, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x4c,
+	0x6f, 0x62, 0x62, 0x79, 0x53, 0x65, 0x74, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12,
+	0x18, 0x0a, 0x07, 0x6c, 0x6f, 0x62, 0x62, 0x79, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x07, 0x6c, 0x6f, 0x62, 0x62, 0x79, 0x49, 0x44, 0x12, 0x1f, 0x0a, 0x08, 0x70, 0x61, 0x73,
+	0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x08, 0x70,
+	0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x88, 0x01, 0x01, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x70,
+	0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x22, 0x4f, 0x0a, 0x17, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x4c, 0x6f, 0x62, 0x62, 0x79, 0x53, 0x65, 0x74, 0x4a, 0x6f, 0x69, 0x6e, 0x61, 0x62,
+	0x6c, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6c, 0x6f, 0x62, 0x62, 0x79, 0x49, 0x44, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x07, 0x6c, 0x6f, 0x62, 0x62, 0x79, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08,
+	0x6a, 0x6f, 0x69, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08,
+	0x6a, 0x6f, 0x69, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x22, 0x5f, 0x0a, 0x19, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x4c, 0x6f, 0x62, 0x62, 0x79, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72,
+	0x4f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x6c, 0x6f, 0x62, 0x62, 0x79, 0x49, 0x44,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x6c, 0x6f, 0x62, 0x62, 0x79, 0x49, 0x44, 0x12,
+	0x28, 0x0a, 0x0f, 0x73, 0x74, 0x65, 0x61, 0x6d, 0x49, 0x64, 0x4e, 0x65, 0x77, 0x4f, 0x77, 0x6e,
+	0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x73, 0x74, 0x65, 0x61, 0x6d, 0x49,
+	0x64, 0x4e, 0x65, 0x77, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x22, 0x4b, 0x0a, 0x19, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x4c, 0x6f, 0x62, 0x62, 0x79, 0x53, 0x65, 0x74, 0x53, 0x70, 0x65, 0x63,
+	0x74, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6c, 0x6f, 0x62, 0x62, 0x79, 0x49,
+	0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x6c, 0x6f, 0x62, 0x62, 0x79, 0x49, 0x44,
+	0x12, 0x14, 0x0a, 0x05, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x05, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x22, 0xa3, 0x07, 0x0a, 0x0e, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x3d, 0x0a, 0x04, 0x74, 0x79, 0x70,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x29, 0x2e, 0x50, 0x61, 0x70, 0x65, 0x72, 0x2e,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x2e, 0x52,
 	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x54, 0x79,
-	0x70, 0x65, 0x12, 0x08, 0x0a, 0x04, 0x54, 0x69, 0x6d, 0x65, 0x10, 0x00, 0x12, 0x0d, 0x0a, 0x09,
-	0x4c, 0x6f, 0x62, 0x62, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x10, 0x01, 0x12, 0x13, 0x0a, 0x0f, 0x4c,
-	0x6f, 0x67, 0x69, 0x6e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x10, 0x02,
-	0x12, 0x10, 0x0a, 0x0c, 0x4c, 0x6f, 0x62, 0x62, 0x79, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
-	0x10, 0x03, 0x12, 0x13, 0x0a, 0x0f, 0x4c, 0x6f, 0x62, 0x62, 0x79, 0x44, 0x61, 0x74, 0x61, 0x55,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x10, 0x04, 0x12, 0x19, 0x0a, 0x15, 0x4c, 0x6f, 0x62, 0x62, 0x79,
-	0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x44, 0x61, 0x74, 0x61, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x10, 0x05, 0x12, 0x13, 0x0a, 0x0f, 0x4c, 0x6f, 0x62, 0x62, 0x79, 0x43, 0x68, 0x61, 0x74, 0x55,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x10, 0x06, 0x12, 0x0d, 0x0a, 0x09, 0x4c, 0x6f, 0x62, 0x62, 0x79,
-	0x4a, 0x6f, 0x69, 0x6e, 0x10, 0x07, 0x12, 0x12, 0x0a, 0x0e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x55, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x10, 0x08, 0x12, 0x14, 0x0a, 0x10, 0x48, 0x61,
-	0x73, 0x4e, 0x65, 0x77, 0x50, 0x32, 0x50, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x10, 0x09,
-	0x12, 0x14, 0x0a, 0x10, 0x50, 0x32, 0x50, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x41, 0x72,
-	0x72, 0x69, 0x76, 0x65, 0x10, 0x0a, 0x12, 0x17, 0x0a, 0x13, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72,
-	0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x10, 0x0b, 0x12,
-	0x12, 0x0a, 0x0e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x55, 0x64, 0x70, 0x54, 0x6f, 0x6b, 0x65,
-	0x6e, 0x10, 0x0c, 0x12, 0x12, 0x0a, 0x0e, 0x4c, 0x6f, 0x67, 0x43, 0x6f, 0x6e, 0x73, 0x6f, 0x6c,
-	0x65, 0x43, 0x68, 0x61, 0x74, 0x10, 0x0d, 0x12, 0x17, 0x0a, 0x13, 0x55, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x55, 0x73, 0x65, 0x72, 0x55, 0x64, 0x70, 0x49, 0x70, 0x41, 0x64, 0x64, 0x72, 0x10, 0x0e,
-	0x12, 0x24, 0x0a, 0x20, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4c, 0x6f, 0x67, 0x43, 0x6f, 0x6e,
-	0x73, 0x6f, 0x6c, 0x65, 0x43, 0x68, 0x61, 0x74, 0x46, 0x61, 0x73, 0x74, 0x4d, 0x65, 0x73, 0x73,
-	0x61, 0x67, 0x65, 0x73, 0x10, 0x0f, 0x12, 0x1d, 0x0a, 0x19, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x6f, 0x6d, 0x4e, 0x61, 0x6d, 0x65, 0x4c, 0x69,
-	0x73, 0x74, 0x73, 0x10, 0x10, 0x22, 0x37, 0x0a, 0x21, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x4c, 0x6f, 0x67, 0x43, 0x6f, 0x6e, 0x73, 0x6f, 0x6c, 0x65, 0x43, 0x68, 0x61, 0x74, 0x46,
-	0x61, 0x73, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6d, 0x73,
-	0x67, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x6d, 0x73, 0x67, 0x73, 0x22, 0x33,
-	0x0a, 0x1b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x52, 0x6f, 0x6f, 0x6d, 0x4e, 0x61, 0x6d, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x73, 0x12, 0x14, 0x0a,
-	0x05, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x6e, 0x61,
-	0x6d, 0x65, 0x73, 0x22, 0x2c, 0x0a, 0x0c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x54,
-	0x69, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
-	0x70, 0x22, 0xa6, 0x02, 0x0a, 0x09, 0x4c, 0x6f, 0x62, 0x62, 0x79, 0x49, 0x6e, 0x66, 0x6f, 0x12,
-	0x18, 0x0a, 0x07, 0x6c, 0x6f, 0x62, 0x62, 0x79, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
-	0x52, 0x07, 0x6c, 0x6f, 0x62, 0x62, 0x79, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x6f, 0x77, 0x6e,
-	0x65, 0x72, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x6f, 0x77, 0x6e, 0x65,
-	0x72, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x73, 0x18, 0x03,
-	0x20, 0x03, 0x28, 0x04, 0x52, 0x07, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x73, 0x12, 0x30, 0x0a,
-	0x05, 0x64, 0x61, 0x74, 0x61, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x50,
-	0x61, 0x70, 0x65, 0x72, 0x2e, 0x4c, 0x6f, 0x62, 0x62, 0x79, 0x44, 0x61, 0x74, 0x61, 0x55, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x05, 0x64, 0x61, 0x74, 0x61, 0x73, 0x12,
-	0x35, 0x0a, 0x0a, 0x75, 0x73, 0x65, 0x72, 0x73, 0x44, 0x61, 0x74, 0x61, 0x73, 0x18, 0x05, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x50, 0x61, 0x70, 0x65, 0x72, 0x2e, 0x53, 0x69, 0x6e, 0x67,
-	0x6c, 0x65, 0x55, 0x73, 0x65, 0x72, 0x44, 0x61, 0x74, 0x61, 0x52, 0x0a, 0x75, 0x73, 0x65, 0x72,
-	0x73, 0x44, 0x61, 0x74, 0x61, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x06,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x68, 0x61,
-	0x73, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x0b, 0x68, 0x61, 0x73, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x1f, 0x0a, 0x08,
-	0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00,
-	0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x88, 0x01, 0x01, 0x42, 0x0b, 0x0a,
-	0x09, 0x5f, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x22, 0x30, 0x0a, 0x12, 0x53, 0x69,
-	0x6e, 0x67, 0x6c, 0x65, 0x55, 0x73, 0x65, 0x72, 0x44, 0x61, 0x74, 0x61, 0x49, 0x74, 0x65, 0x6d,
-	0x12, 0x0c, 0x0a, 0x01, 0x4b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x01, 0x4b, 0x12, 0x0c,
-	0x0a, 0x01, 0x56, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x01, 0x56, 0x22, 0x77, 0x0a, 0x0e,
-	0x53, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x55, 0x73, 0x65, 0x72, 0x44, 0x61, 0x74, 0x61, 0x12, 0x2d,
-	0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x50,
-	0x61, 0x70, 0x65, 0x72, 0x2e, 0x53, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x55, 0x73, 0x65, 0x72, 0x44,
-	0x61, 0x74, 0x61, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x1c, 0x0a,
-	0x09, 0x75, 0x64, 0x70, 0x49, 0x70, 0x41, 0x64, 0x64, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c,
-	0x52, 0x09, 0x75, 0x64, 0x70, 0x49, 0x70, 0x41, 0x64, 0x64, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x75,
-	0x64, 0x70, 0x50, 0x6f, 0x72, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x75, 0x64,
-	0x70, 0x50, 0x6f, 0x72, 0x74, 0x22, 0x66, 0x0a, 0x10, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x55, 0x73, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x6f, 0x62,
-	0x62, 0x79, 0x70, 0x6f, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x6c, 0x6f, 0x62,
-	0x62, 0x79, 0x70, 0x6f, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x75, 0x64, 0x70, 0x49, 0x70, 0x41, 0x64,
-	0x64, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x75, 0x64, 0x70, 0x49, 0x70, 0x41,
-	0x64, 0x64, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x75, 0x64, 0x70, 0x50, 0x6f, 0x72, 0x74, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x75, 0x64, 0x70, 0x50, 0x6f, 0x72, 0x74, 0x22, 0x3f, 0x0a,
-	0x11, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x4c, 0x6f, 0x62, 0x62, 0x79, 0x4c, 0x69,
-	0x73, 0x74, 0x12, 0x2a, 0x0a, 0x07, 0x6c, 0x6f, 0x62, 0x62, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x50, 0x61, 0x70, 0x65, 0x72, 0x2e, 0x4c, 0x6f, 0x62, 0x62,
-	0x79, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x07, 0x6c, 0x6f, 0x62, 0x62, 0x69, 0x65, 0x73, 0x22, 0x56,
-	0x0a, 0x14, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x4c, 0x6f, 0x62, 0x62, 0x79, 0x43,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x6c, 0x6f, 0x62, 0x62, 0x79, 0x49,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x6c, 0x6f, 0x62, 0x62, 0x79, 0x49, 0x64,
-	0x12, 0x24, 0x0a, 0x04, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10,
-	0x2e, 0x50, 0x61, 0x70, 0x65, 0x72, 0x2e, 0x4c, 0x6f, 0x62, 0x62, 0x79, 0x49, 0x6e, 0x66, 0x6f,
-	0x52, 0x04, 0x69, 0x6e, 0x66, 0x6f, 0x22, 0x44, 0x0a, 0x16, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f,
-	0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
-	0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x31, 0x0a, 0x13,
+	0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x65, 0x6e, 0x67,
+	0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68,
+	0x12, 0x1c, 0x0a, 0x09, 0x68, 0x6f, 0x6c, 0x64, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x09, 0x68, 0x6f, 0x6c, 0x64, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x24,
+	0x0a, 0x0d, 0x66, 0x72, 0x61, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x66, 0x72, 0x61, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x49,
+	0x6e, 0x64, 0x65, 0x78, 0x12, 0x24, 0x0a, 0x0d, 0x66, 0x72, 0x61, 0x67, 0x6d, 0x65, 0x6e, 0x74,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x66, 0x72, 0x61,
+	0x67, 0x6d, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x74, 0x6f,
+	0x74, 0x61, 0x6c, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x22, 0xad, 0x05, 0x0a,
+	0x13, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x54, 0x79, 0x70, 0x65, 0x12, 0x08, 0x0a, 0x04, 0x54, 0x69, 0x6d, 0x65, 0x10, 0x00, 0x12, 0x0d,
+	0x0a, 0x09, 0x4c, 0x6f, 0x62, 0x62, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x10, 0x01, 0x12, 0x13, 0x0a,
+	0x0f, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f,
+	0x10, 0x02, 0x12, 0x10, 0x0a, 0x0c, 0x4c, 0x6f, 0x62, 0x62, 0x79, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x64, 0x10, 0x03, 0x12, 0x13, 0x0a, 0x0f, 0x4c, 0x6f, 0x62, 0x62, 0x79, 0x44, 0x61, 0x74,
+	0x61, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x10, 0x04, 0x12, 0x19, 0x0a, 0x15, 0x4c, 0x6f, 0x62,
+	0x62, 0x79, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x44, 0x61, 0x74, 0x61, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x10, 0x05, 0x12, 0x13, 0x0a, 0x0f, 0x4c, 0x6f, 0x62, 0x62, 0x79, 0x43, 0x68, 0x61,
+	0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x10, 0x06, 0x12, 0x0d, 0x0a, 0x09, 0x4c, 0x6f, 0x62,
+	0x62, 0x79, 0x4a, 0x6f, 0x69, 0x6e, 0x10, 0x07, 0x12, 0x12, 0x0a, 0x0e, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x10, 0x08, 0x12, 0x14, 0x0a, 0x10,
+	0x48, 0x61, 0x73, 0x4e, 0x65, 0x77, 0x50, 0x32, 0x50, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65,
+	0x10, 0x09, 0x12, 0x14, 0x0a, 0x10, 0x50, 0x32, 0x50, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x41, 0x72, 0x72, 0x69, 0x76, 0x65, 0x10, 0x0a, 0x12, 0x17, 0x0a, 0x13, 0x53, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x10,
+	0x0b, 0x12, 0x12, 0x0a, 0x0e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x55, 0x64, 0x70, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x10, 0x0c, 0x12, 0x12, 0x0a, 0x0e, 0x4c, 0x6f, 0x67, 0x43, 0x6f, 0x6e, 0x73,
+	0x6f, 0x6c, 0x65, 0x43, 0x68, 0x61, 0x74, 0x10, 0x0d, 0x12, 0x17, 0x0a, 0x13, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x55, 0x64, 0x70, 0x49, 0x70, 0x41, 0x64, 0x64, 0x72,
+	0x10, 0x0e, 0x12, 0x24, 0x0a, 0x20, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4c, 0x6f, 0x67, 0x43,
+	0x6f, 0x6e, 0x73, 0x6f, 0x6c, 0x65, 0x43, 0x68, 0x61, 0x74, 0x46, 0x61, 0x73, 0x74, 0x4d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x10, 0x0f, 0x12, 0x1d, 0x0a, 0x19, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x6f, 0x6d, 0x4e, 0x61, 0x6d, 0x65,
+	0x4c, 0x69, 0x73, 0x74, 0x73, 0x10, 0x10, 0x12, 0x0e, 0x0a, 0x0a, 0x47, 0x6c, 0x6f, 0x62, 0x61,
+	0x6c, 0x43, 0x68, 0x61, 0x74, 0x10, 0x11, 0x12, 0x14, 0x0a, 0x10, 0x47, 0x6c, 0x6f, 0x62, 0x61,
+	0x6c, 0x43, 0x68, 0x61, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x10, 0x12, 0x12, 0x0b, 0x0a,
+	0x07, 0x57, 0x68, 0x69, 0x73, 0x70, 0x65, 0x72, 0x10, 0x13, 0x12, 0x15, 0x0a, 0x11, 0x4c, 0x6f,
+	0x62, 0x62, 0x79, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x10,
+	0x14, 0x12, 0x15, 0x0a, 0x11, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65,
+	0x4c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x10, 0x15, 0x12, 0x11, 0x0a, 0x0d, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x10, 0x16, 0x12, 0x12, 0x0a, 0x0e, 0x4c,
+	0x6f, 0x62, 0x62, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x65, 0x6c, 0x74, 0x61, 0x10, 0x17, 0x12,
+	0x13, 0x0a, 0x0f, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x6d, 0x61, 0x6b, 0x65, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x10, 0x18, 0x12, 0x13, 0x0a, 0x0f, 0x4c, 0x6f, 0x62, 0x62, 0x79, 0x49, 0x6e, 0x76,
+	0x69, 0x74, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x10, 0x19, 0x12, 0x13, 0x0a, 0x0f, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x4c, 0x6f, 0x62, 0x62, 0x79, 0x44, 0x61, 0x74, 0x61, 0x10, 0x1a, 0x12, 0x14,
+	0x0a, 0x10, 0x4c, 0x6f, 0x62, 0x62, 0x79, 0x4a, 0x6f, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x10, 0x1b, 0x12, 0x10, 0x0a, 0x0c, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x4c, 0x69, 0x73, 0x74, 0x10, 0x1c, 0x12, 0x12, 0x0a, 0x0e, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64,
+	0x50, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x63, 0x65, 0x10, 0x1d, 0x12, 0x0c, 0x0a, 0x08, 0x50, 0x72,
+	0x65, 0x73, 0x65, 0x6e, 0x63, 0x65, 0x10, 0x1e, 0x12, 0x12, 0x0a, 0x0e, 0x50, 0x72, 0x65, 0x73,
+	0x65, 0x6e, 0x63, 0x65, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x10, 0x1f, 0x22, 0x89, 0x01, 0x0a,
+	0x19, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x53, 0x69, 0x7a, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x12, 0x26, 0x0a, 0x0e, 0x6d, 0x61,
+	0x78, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x0e, 0x6d, 0x61, 0x78, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x53, 0x69,
+	0x7a, 0x65, 0x12, 0x26, 0x0a, 0x0e, 0x6d, 0x61, 0x78, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x53, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e, 0x6d, 0x61, 0x78, 0x4d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x66, 0x72,
+	0x61, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x66,
+	0x72, 0x61, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x22, 0xb6, 0x02, 0x0a, 0x15, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x46, 0x61, 0x69, 0x6c,
+	0x65, 0x64, 0x12, 0x4d, 0x0a, 0x0c, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x54, 0x79,
+	0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x29, 0x2e, 0x50, 0x61, 0x70, 0x65, 0x72,
+	0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x2e,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x54,
+	0x79, 0x70, 0x65, 0x52, 0x0c, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x54, 0x79, 0x70,
+	0x65, 0x12, 0x3b, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x23, 0x2e, 0x50, 0x61, 0x70, 0x65, 0x72, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x2e,
+	0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x18,
+	0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x77, 0x0a, 0x06, 0x52, 0x65, 0x61, 0x73,
+	0x6f, 0x6e, 0x12, 0x0b, 0x0a, 0x07, 0x55, 0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x10, 0x00, 0x12,
+	0x0c, 0x0a, 0x08, 0x54, 0x6f, 0x6f, 0x4c, 0x61, 0x72, 0x67, 0x65, 0x10, 0x01, 0x12, 0x11, 0x0a,
+	0x0d, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x45, 0x78, 0x63, 0x65, 0x65, 0x64, 0x65, 0x64, 0x10, 0x02,
+	0x12, 0x0f, 0x0a, 0x0b, 0x49, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x44, 0x61, 0x74, 0x61, 0x10,
+	0x03, 0x12, 0x0d, 0x0a, 0x09, 0x46, 0x6f, 0x72, 0x62, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x10, 0x04,
+	0x12, 0x0f, 0x0a, 0x0b, 0x52, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x65, 0x64, 0x10,
+	0x05, 0x12, 0x0e, 0x0a, 0x0a, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x46, 0x75, 0x6c, 0x6c, 0x10,
+	0x06, 0x22, 0x69, 0x0a, 0x17, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x4c, 0x6f, 0x62, 0x62, 0x79, 0x44, 0x61, 0x74, 0x61, 0x12, 0x18, 0x0a, 0x07,
+	0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73,
+	0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x34, 0x0a, 0x07, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e,
+	0x74, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x50, 0x61, 0x70, 0x65, 0x72, 0x2e,
 	0x4c, 0x6f, 0x62, 0x62, 0x79, 0x44, 0x61, 0x74, 0x61, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x49,
-	0x74, 0x65, 0x6d, 0x12, 0x0c, 0x0a, 0x01, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x01,
-	0x6b, 0x12, 0x0c, 0x0a, 0x01, 0x76, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x01, 0x76, 0x22,
-	0xb7, 0x01, 0x0a, 0x17, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x4c, 0x6f, 0x62, 0x62,
-	0x79, 0x44, 0x61, 0x74, 0x61, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x73,
-	0x74, 0x65, 0x61, 0x6d, 0x49, 0x64, 0x4c, 0x6f, 0x62, 0x62, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x04, 0x52, 0x0c, 0x73, 0x74, 0x65, 0x61, 0x6d, 0x49, 0x64, 0x4c, 0x6f, 0x62, 0x62, 0x79, 0x12,
-	0x24, 0x0a, 0x0d, 0x73, 0x74, 0x65, 0x61, 0x6d, 0x49, 0x64, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x73, 0x74, 0x65, 0x61, 0x6d, 0x49, 0x64, 0x4d,
-	0x65, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x20, 0x0a, 0x0b, 0x6f, 0x6e, 0x6c, 0x79, 0x4c, 0x6f, 0x62,
-	0x62, 0x79, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x6f, 0x6e, 0x6c, 0x79,
-	0x4c, 0x6f, 0x62, 0x62, 0x79, 0x49, 0x64, 0x12, 0x30, 0x0a, 0x05, 0x64, 0x61, 0x74, 0x61, 0x73,
-	0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x50, 0x61, 0x70, 0x65, 0x72, 0x2e, 0x4c,
-	0x6f, 0x62, 0x62, 0x79, 0x44, 0x61, 0x74, 0x61, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x49, 0x74,
-	0x65, 0x6d, 0x52, 0x05, 0x64, 0x61, 0x74, 0x61, 0x73, 0x22, 0xb0, 0x04, 0x0a, 0x17, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x4c, 0x6f, 0x62, 0x62, 0x79, 0x43, 0x68, 0x61, 0x74, 0x55,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x73, 0x74, 0x65, 0x61, 0x6d, 0x49, 0x64,
-	0x4c, 0x6f, 0x62, 0x62, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x73, 0x74, 0x65,
-	0x61, 0x6d, 0x49, 0x64, 0x4c, 0x6f, 0x62, 0x62, 0x79, 0x12, 0x2e, 0x0a, 0x12, 0x73, 0x74, 0x65,
-	0x61, 0x6d, 0x49, 0x64, 0x55, 0x73, 0x65, 0x72, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x12, 0x73, 0x74, 0x65, 0x61, 0x6d, 0x49, 0x64, 0x55, 0x73,
-	0x65, 0x72, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x12, 0x3c, 0x0a, 0x19, 0x73, 0x74, 0x65,
-	0x61, 0x6d, 0x49, 0x64, 0x55, 0x73, 0x65, 0x72, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x49,
-	0x73, 0x4c, 0x6f, 0x62, 0x62, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x19, 0x73, 0x74,
-	0x65, 0x61, 0x6d, 0x49, 0x64, 0x55, 0x73, 0x65, 0x72, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64,
-	0x49, 0x73, 0x4c, 0x6f, 0x62, 0x62, 0x79, 0x12, 0x30, 0x0a, 0x13, 0x73, 0x74, 0x65, 0x61, 0x6d,
-	0x49, 0x64, 0x4d, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x04, 0x52, 0x13, 0x73, 0x74, 0x65, 0x61, 0x6d, 0x49, 0x64, 0x4d, 0x61, 0x6b,
-	0x69, 0x6e, 0x67, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x3e, 0x0a, 0x1a, 0x73, 0x74, 0x65,
-	0x61, 0x6d, 0x49, 0x64, 0x4d, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65,
-	0x49, 0x73, 0x4c, 0x6f, 0x62, 0x62, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x1a, 0x73,
-	0x74, 0x65, 0x61, 0x6d, 0x49, 0x64, 0x4d, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x43, 0x68, 0x61, 0x6e,
-	0x67, 0x65, 0x49, 0x73, 0x4c, 0x6f, 0x62, 0x62, 0x79, 0x12, 0x6a, 0x0a, 0x15, 0x63, 0x68, 0x61,
-	0x74, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x43, 0x68, 0x61, 0x6e,
-	0x67, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x34, 0x2e, 0x50, 0x61, 0x70, 0x65, 0x72,
-	0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x4c, 0x6f, 0x62, 0x62, 0x79, 0x43, 0x68,
-	0x61, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x43, 0x68, 0x61, 0x74, 0x4d, 0x65, 0x6d,
-	0x62, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x15,
+	0x74, 0x65, 0x6d, 0x52, 0x07, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x22, 0x80, 0x01, 0x0a,
+	0x18, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x4c, 0x6f, 0x62, 0x62, 0x79, 0x4a, 0x6f,
+	0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x6c, 0x6f, 0x62,
+	0x62, 0x79, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x6c, 0x6f, 0x62, 0x62,
+	0x79, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x74, 0x65, 0x61, 0x6d, 0x49, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x73, 0x74, 0x65, 0x61, 0x6d, 0x49, 0x64, 0x12, 0x12, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x65, 0x64, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x65, 0x64, 0x22,
+	0x74, 0x0a, 0x14, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x65, 0x6c, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x2e, 0x0a, 0x07, 0x66, 0x72, 0x69, 0x65, 0x6e,
+	0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x50, 0x61, 0x70, 0x65, 0x72,
+	0x2e, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07,
+	0x66, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x73, 0x12, 0x2c, 0x0a, 0x06, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x50, 0x61, 0x70, 0x65, 0x72, 0x2e,
+	0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x62,
+	0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x22, 0x55, 0x0a, 0x0d, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x74, 0x65, 0x61, 0x6d, 0x49,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x73, 0x74, 0x65, 0x61, 0x6d, 0x49, 0x64,
+	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x6f, 0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x22, 0x5e, 0x0a, 0x16,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x50, 0x72,
+	0x65, 0x73, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x74, 0x65, 0x61, 0x6d, 0x49,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x73, 0x74, 0x65, 0x61, 0x6d, 0x49, 0x64,
+	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x6f, 0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x22, 0xde, 0x01, 0x0a,
+	0x0e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x50, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x63, 0x65, 0x12,
+	0x18, 0x0a, 0x07, 0x73, 0x74, 0x65, 0x61, 0x6d, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x07, 0x73, 0x74, 0x65, 0x61, 0x6d, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x2a, 0x0a,
+	0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x50,
+	0x61, 0x70, 0x65, 0x72, 0x2e, 0x50, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x63, 0x65, 0x53, 0x74, 0x61,
+	0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6c, 0x6f, 0x62,
+	0x62, 0x79, 0x49, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x6c, 0x6f, 0x62, 0x62,
+	0x79, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x6c, 0x6f, 0x62, 0x62, 0x79, 0x4e, 0x61, 0x6d, 0x65,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6c, 0x6f, 0x62, 0x62, 0x79, 0x4e, 0x61, 0x6d,
+	0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6a, 0x6f, 0x69, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x08, 0x6a, 0x6f, 0x69, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x1e, 0x0a,
+	0x0a, 0x73, 0x70, 0x65, 0x63, 0x74, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x0a, 0x73, 0x70, 0x65, 0x63, 0x74, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x22, 0x43, 0x0a,
+	0x10, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x50, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x63,
+	0x65, 0x12, 0x2f, 0x0a, 0x07, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x15, 0x2e, 0x50, 0x61, 0x70, 0x65, 0x72, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65,
+	0x72, 0x50, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x07, 0x70, 0x6c, 0x61, 0x79, 0x65,
+	0x72, 0x73, 0x22, 0x49, 0x0a, 0x16, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x50, 0x72,
+	0x65, 0x73, 0x65, 0x6e, 0x63, 0x65, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x2f, 0x0a, 0x07,
+	0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e,
+	0x50, 0x61, 0x70, 0x65, 0x72, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x50, 0x72, 0x65, 0x73,
+	0x65, 0x6e, 0x63, 0x65, 0x52, 0x07, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x22, 0x47, 0x0a,
+	0x17, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x4c, 0x6f, 0x62, 0x62, 0x79, 0x49, 0x6e,
+	0x76, 0x69, 0x74, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6c, 0x6f, 0x62, 0x62,
+	0x79, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x6c, 0x6f, 0x62, 0x62, 0x79,
+	0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x22, 0xf4, 0x01, 0x0a, 0x17, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x6d, 0x61, 0x6b, 0x65, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x12, 0x3d, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x25, 0x2e, 0x50, 0x61, 0x70, 0x65, 0x72, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x6d, 0x61, 0x6b, 0x65, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x12, 0x18, 0x0a, 0x07, 0x6c, 0x6f, 0x62, 0x62, 0x79, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x07, 0x6c, 0x6f, 0x62, 0x62, 0x79, 0x49, 0x64, 0x12, 0x24, 0x0a, 0x0d, 0x71,
+	0x75, 0x65, 0x75, 0x65, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x0d, 0x71, 0x75, 0x65, 0x75, 0x65, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x71, 0x75, 0x65, 0x75, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x71, 0x75, 0x65, 0x75, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x22,
+	0x3c, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x0a, 0x0a, 0x06, 0x51, 0x75, 0x65,
+	0x75, 0x65, 0x64, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x64,
+	0x10, 0x01, 0x12, 0x0d, 0x0a, 0x09, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x65, 0x64, 0x10,
+	0x02, 0x12, 0x0a, 0x0a, 0x06, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x10, 0x03, 0x22, 0x37, 0x0a,
+	0x21, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x4c, 0x6f, 0x67, 0x43, 0x6f, 0x6e, 0x73,
+	0x6f, 0x6c, 0x65, 0x43, 0x68, 0x61, 0x74, 0x46, 0x61, 0x73, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6d, 0x73, 0x67, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x04, 0x6d, 0x73, 0x67, 0x73, 0x22, 0x6b, 0x0a, 0x1b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x6f, 0x6d, 0x4e, 0x61, 0x6d, 0x65,
+	0x4c, 0x69, 0x73, 0x74, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x12, 0x36, 0x0a, 0x09, 0x74,
+	0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18,
+	0x2e, 0x50, 0x61, 0x70, 0x65, 0x72, 0x2e, 0x4c, 0x6f, 0x62, 0x62, 0x79, 0x54, 0x65, 0x6d, 0x70,
+	0x6c, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x09, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61,
+	0x74, 0x65, 0x73, 0x22, 0xcf, 0x01, 0x0a, 0x11, 0x4c, 0x6f, 0x62, 0x62, 0x79, 0x54, 0x65, 0x6d,
+	0x70, 0x6c, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
+	0x1e, 0x0a, 0x0a, 0x6c, 0x6f, 0x62, 0x62, 0x79, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x0a, 0x6c, 0x6f, 0x62, 0x62, 0x79, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12,
+	0x1e, 0x0a, 0x0a, 0x6d, 0x61, 0x78, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x0a, 0x6d, 0x61, 0x78, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x12,
+	0x1c, 0x0a, 0x09, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x50, 0x32, 0x50, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x09, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x50, 0x32, 0x50, 0x12, 0x36, 0x0a,
+	0x0a, 0x76, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x16, 0x2e, 0x50, 0x61, 0x70, 0x65, 0x72, 0x2e, 0x4c, 0x6f, 0x62, 0x62, 0x79, 0x56,
+	0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x52, 0x0a, 0x76, 0x69, 0x73, 0x69, 0x62,
+	0x69, 0x6c, 0x69, 0x74, 0x79, 0x22, 0x2c, 0x0a, 0x0c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x22, 0xc0, 0x04, 0x0a, 0x09, 0x4c, 0x6f, 0x62, 0x62, 0x79, 0x49, 0x6e, 0x66,
+	0x6f, 0x12, 0x18, 0x0a, 0x07, 0x6c, 0x6f, 0x62, 0x62, 0x79, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x07, 0x6c, 0x6f, 0x62, 0x62, 0x79, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x6f,
+	0x77, 0x6e, 0x65, 0x72, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x6f, 0x77,
+	0x6e, 0x65, 0x72, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x73,
+	0x18, 0x03, 0x20, 0x03, 0x28, 0x04, 0x52, 0x07, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x73, 0x12,
+	0x30, 0x0a, 0x05, 0x64, 0x61, 0x74, 0x61, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x50, 0x61, 0x70, 0x65, 0x72, 0x2e, 0x4c, 0x6f, 0x62, 0x62, 0x79, 0x44, 0x61, 0x74, 0x61,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x05, 0x64, 0x61, 0x74, 0x61,
+	0x73, 0x12, 0x35, 0x0a, 0x0a, 0x75, 0x73, 0x65, 0x72, 0x73, 0x44, 0x61, 0x74, 0x61, 0x73, 0x18,
+	0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x50, 0x61, 0x70, 0x65, 0x72, 0x2e, 0x53, 0x69,
+	0x6e, 0x67, 0x6c, 0x65, 0x55, 0x73, 0x65, 0x72, 0x44, 0x61, 0x74, 0x61, 0x52, 0x0a, 0x75, 0x73,
+	0x65, 0x72, 0x73, 0x44, 0x61, 0x74, 0x61, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b,
+	0x68, 0x61, 0x73, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x0b, 0x68, 0x61, 0x73, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x1f,
+	0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09,
+	0x48, 0x00, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x88, 0x01, 0x01, 0x12,
+	0x20, 0x0a, 0x0b, 0x6e, 0x6f, 0x74, 0x4a, 0x6f, 0x69, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x6e, 0x6f, 0x74, 0x4a, 0x6f, 0x69, 0x6e, 0x61, 0x62, 0x6c,
+	0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x6d, 0x61, 0x78, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x6d, 0x61, 0x78, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72,
+	0x73, 0x12, 0x22, 0x0a, 0x0c, 0x73, 0x70, 0x65, 0x63, 0x74, 0x61, 0x74, 0x6f, 0x72, 0x49, 0x64,
+	0x73, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x04, 0x52, 0x0c, 0x73, 0x70, 0x65, 0x63, 0x74, 0x61, 0x74,
+	0x6f, 0x72, 0x49, 0x64, 0x73, 0x12, 0x30, 0x0a, 0x13, 0x73, 0x70, 0x65, 0x63, 0x74, 0x61, 0x74,
+	0x6f, 0x72, 0x73, 0x46, 0x6f, 0x72, 0x62, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x18, 0x0c, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x13, 0x73, 0x70, 0x65, 0x63, 0x74, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x46, 0x6f,
+	0x72, 0x62, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x6c, 0x6f, 0x62, 0x62, 0x79,
+	0x47, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x6c, 0x6f, 0x62,
+	0x62, 0x79, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x36, 0x0a, 0x0a, 0x76, 0x69, 0x73, 0x69, 0x62,
+	0x69, 0x6c, 0x69, 0x74, 0x79, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x50, 0x61,
+	0x70, 0x65, 0x72, 0x2e, 0x4c, 0x6f, 0x62, 0x62, 0x79, 0x56, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c,
+	0x69, 0x74, 0x79, 0x52, 0x0a, 0x76, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x12,
+	0x28, 0x0a, 0x0f, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76,
+	0x61, 0x6c, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72,
+	0x65, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x70, 0x61,
+	0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x22, 0x30, 0x0a, 0x12, 0x53, 0x69, 0x6e, 0x67, 0x6c, 0x65,
+	0x55, 0x73, 0x65, 0x72, 0x44, 0x61, 0x74, 0x61, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x0c, 0x0a, 0x01,
+	0x4b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x01, 0x4b, 0x12, 0x0c, 0x0a, 0x01, 0x56, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x01, 0x56, 0x22, 0x77, 0x0a, 0x0e, 0x53, 0x69, 0x6e, 0x67,
+	0x6c, 0x65, 0x55, 0x73, 0x65, 0x72, 0x44, 0x61, 0x74, 0x61, 0x12, 0x2d, 0x0a, 0x04, 0x64, 0x61,
+	0x74, 0x61, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x50, 0x61, 0x70, 0x65, 0x72,
+	0x2e, 0x53, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x55, 0x73, 0x65, 0x72, 0x44, 0x61, 0x74, 0x61, 0x49,
+	0x74, 0x65, 0x6d, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x1c, 0x0a, 0x09, 0x75, 0x64, 0x70,
+	0x49, 0x70, 0x41, 0x64, 0x64, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x75, 0x64,
+	0x70, 0x49, 0x70, 0x41, 0x64, 0x64, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x75, 0x64, 0x70, 0x50, 0x6f,
+	0x72, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x75, 0x64, 0x70, 0x50, 0x6f, 0x72,
+	0x74, 0x22, 0x66, 0x0a, 0x10, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x55, 0x73, 0x65,
+	0x72, 0x41, 0x64, 0x64, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x6f, 0x62, 0x62, 0x79, 0x70, 0x6f,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x6c, 0x6f, 0x62, 0x62, 0x79, 0x70, 0x6f,
+	0x73, 0x12, 0x1c, 0x0a, 0x09, 0x75, 0x64, 0x70, 0x49, 0x70, 0x41, 0x64, 0x64, 0x72, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x75, 0x64, 0x70, 0x49, 0x70, 0x41, 0x64, 0x64, 0x72, 0x12,
+	0x18, 0x0a, 0x07, 0x75, 0x64, 0x70, 0x50, 0x6f, 0x72, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x07, 0x75, 0x64, 0x70, 0x50, 0x6f, 0x72, 0x74, 0x22, 0x7f, 0x0a, 0x11, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x4c, 0x6f, 0x62, 0x62, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x2a,
+	0x0a, 0x07, 0x6c, 0x6f, 0x62, 0x62, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x10, 0x2e, 0x50, 0x61, 0x70, 0x65, 0x72, 0x2e, 0x4c, 0x6f, 0x62, 0x62, 0x79, 0x49, 0x6e, 0x66,
+	0x6f, 0x52, 0x07, 0x6c, 0x6f, 0x62, 0x62, 0x69, 0x65, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x6e, 0x65,
+	0x78, 0x74, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x6e, 0x65, 0x78, 0x74, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x12, 0x1e, 0x0a, 0x0a, 0x74, 0x6f,
+	0x74, 0x61, 0x6c, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a,
+	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x8f, 0x02, 0x0a, 0x16, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x4c, 0x6f, 0x62, 0x62, 0x79, 0x4c, 0x69, 0x73, 0x74,
+	0x44, 0x65, 0x6c, 0x74, 0x61, 0x12, 0x20, 0x0a, 0x0b, 0x62, 0x61, 0x73, 0x65, 0x56, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x62, 0x61, 0x73, 0x65,
+	0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x08, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x12, 0x26, 0x0a,
+	0x05, 0x61, 0x64, 0x64, 0x65, 0x64, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x50,
+	0x61, 0x70, 0x65, 0x72, 0x2e, 0x4c, 0x6f, 0x62, 0x62, 0x79, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x05,
+	0x61, 0x64, 0x64, 0x65, 0x64, 0x12, 0x2a, 0x0a, 0x07, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64,
+	0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x50, 0x61, 0x70, 0x65, 0x72, 0x2e, 0x4c,
+	0x6f, 0x62, 0x62, 0x79, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x07, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x64, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x18, 0x06, 0x20, 0x03,
+	0x28, 0x04, 0x52, 0x07, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x12, 0x2f, 0x0a, 0x07, 0x70,
+	0x61, 0x74, 0x63, 0x68, 0x65, 0x64, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x50,
+	0x61, 0x70, 0x65, 0x72, 0x2e, 0x4c, 0x6f, 0x62, 0x62, 0x79, 0x49, 0x6e, 0x66, 0x6f, 0x50, 0x61,
+	0x74, 0x63, 0x68, 0x52, 0x07, 0x70, 0x61, 0x74, 0x63, 0x68, 0x65, 0x64, 0x22, 0xba, 0x01, 0x0a,
+	0x0e, 0x4c, 0x6f, 0x62, 0x62, 0x79, 0x49, 0x6e, 0x66, 0x6f, 0x50, 0x61, 0x74, 0x63, 0x68, 0x12,
+	0x18, 0x0a, 0x07, 0x6c, 0x6f, 0x62, 0x62, 0x79, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x07, 0x6c, 0x6f, 0x62, 0x62, 0x79, 0x49, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x6d, 0x65, 0x6d,
+	0x62, 0x65, 0x72, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b,
+	0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x75,
+	0x73, 0x65, 0x72, 0x49, 0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x04, 0x52, 0x07, 0x75, 0x73,
+	0x65, 0x72, 0x49, 0x64, 0x73, 0x12, 0x30, 0x0a, 0x05, 0x64, 0x61, 0x74, 0x61, 0x73, 0x18, 0x04,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x50, 0x61, 0x70, 0x65, 0x72, 0x2e, 0x4c, 0x6f, 0x62,
+	0x62, 0x79, 0x44, 0x61, 0x74, 0x61, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x49, 0x74, 0x65, 0x6d,
+	0x52, 0x05, 0x64, 0x61, 0x74, 0x61, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x64, 0x4b, 0x65, 0x79, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x64, 0x4b, 0x65, 0x79, 0x73, 0x22, 0x56, 0x0a, 0x14, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x4c, 0x6f, 0x62, 0x62, 0x79, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x12, 0x18, 0x0a, 0x07, 0x6c, 0x6f, 0x62, 0x62, 0x79, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x07, 0x6c, 0x6f, 0x62, 0x62, 0x79, 0x49, 0x64, 0x12, 0x24, 0x0a, 0x04, 0x69,
+	0x6e, 0x66, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x50, 0x61, 0x70, 0x65,
+	0x72, 0x2e, 0x4c, 0x6f, 0x62, 0x62, 0x79, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x04, 0x69, 0x6e, 0x66,
+	0x6f, 0x22, 0x44, 0x0a, 0x16, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x16, 0x0a, 0x06, 0x75,
+	0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x75, 0x73, 0x65,
+	0x72, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x31, 0x0a, 0x13, 0x4c, 0x6f, 0x62, 0x62, 0x79,
+	0x44, 0x61, 0x74, 0x61, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x0c,
+	0x0a, 0x01, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x01, 0x6b, 0x12, 0x0c, 0x0a, 0x01,
+	0x76, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x01, 0x76, 0x22, 0xd9, 0x01, 0x0a, 0x17, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x4c, 0x6f, 0x62, 0x62, 0x79, 0x44, 0x61, 0x74, 0x61,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x73, 0x74, 0x65, 0x61, 0x6d, 0x49,
+	0x64, 0x4c, 0x6f, 0x62, 0x62, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x73, 0x74,
+	0x65, 0x61, 0x6d, 0x49, 0x64, 0x4c, 0x6f, 0x62, 0x62, 0x79, 0x12, 0x24, 0x0a, 0x0d, 0x73, 0x74,
+	0x65, 0x61, 0x6d, 0x49, 0x64, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x0d, 0x73, 0x74, 0x65, 0x61, 0x6d, 0x49, 0x64, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72,
+	0x12, 0x20, 0x0a, 0x0b, 0x6f, 0x6e, 0x6c, 0x79, 0x4c, 0x6f, 0x62, 0x62, 0x79, 0x49, 0x64, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x6f, 0x6e, 0x6c, 0x79, 0x4c, 0x6f, 0x62, 0x62, 0x79,
+	0x49, 0x64, 0x12, 0x30, 0x0a, 0x05, 0x64, 0x61, 0x74, 0x61, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x50, 0x61, 0x70, 0x65, 0x72, 0x2e, 0x4c, 0x6f, 0x62, 0x62, 0x79, 0x44,
+	0x61, 0x74, 0x61, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x05, 0x64,
+	0x61, 0x74, 0x61, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x4b,
+	0x65, 0x79, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x64, 0x4b, 0x65, 0x79, 0x73, 0x22, 0xc3, 0x01, 0x0a, 0x19, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x4c, 0x6f, 0x62, 0x62, 0x79, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x43, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x64, 0x12, 0x22, 0x0a, 0x0c, 0x73, 0x74, 0x65, 0x61, 0x6d, 0x49, 0x64, 0x4c,
+	0x6f, 0x62, 0x62, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x73, 0x74, 0x65, 0x61,
+	0x6d, 0x49, 0x64, 0x4c, 0x6f, 0x62, 0x62, 0x79, 0x12, 0x28, 0x0a, 0x0f, 0x73, 0x74, 0x65, 0x61,
+	0x6d, 0x49, 0x64, 0x4f, 0x6c, 0x64, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x0f, 0x73, 0x74, 0x65, 0x61, 0x6d, 0x49, 0x64, 0x4f, 0x6c, 0x64, 0x4f, 0x77, 0x6e,
+	0x65, 0x72, 0x12, 0x28, 0x0a, 0x0f, 0x73, 0x74, 0x65, 0x61, 0x6d, 0x49, 0x64, 0x4e, 0x65, 0x77,
+	0x4f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x73, 0x74, 0x65,
+	0x61, 0x6d, 0x49, 0x64, 0x4e, 0x65, 0x77, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x2e, 0x0a, 0x09,
+	0x6c, 0x6f, 0x62, 0x62, 0x79, 0x49, 0x6e, 0x66, 0x6f, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x10, 0x2e, 0x50, 0x61, 0x70, 0x65, 0x72, 0x2e, 0x4c, 0x6f, 0x62, 0x62, 0x79, 0x49, 0x6e, 0x66,
+	0x6f, 0x52, 0x09, 0x6c, 0x6f, 0x62, 0x62, 0x79, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0xb0, 0x04, 0x0a,
+	0x17, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x4c, 0x6f, 0x62, 0x62, 0x79, 0x43, 0x68,
+	0x61, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x73, 0x74, 0x65, 0x61,
+	0x6d, 0x49, 0x64, 0x4c, 0x6f, 0x62, 0x62, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c,
+	0x73, 0x74, 0x65, 0x61, 0x6d, 0x49, 0x64, 0x4c, 0x6f, 0x62, 0x62, 0x79, 0x12, 0x2e, 0x0a, 0x12,
+	0x73, 0x74, 0x65, 0x61, 0x6d, 0x49, 0x64, 0x55, 0x73, 0x65, 0x72, 0x43, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x12, 0x73, 0x74, 0x65, 0x61, 0x6d, 0x49,
+	0x64, 0x55, 0x73, 0x65, 0x72, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x12, 0x3c, 0x0a, 0x19,
+	0x73, 0x74, 0x65, 0x61, 0x6d, 0x49, 0x64, 0x55, 0x73, 0x65, 0x72, 0x43, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x64, 0x49, 0x73, 0x4c, 0x6f, 0x62, 0x62, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x19, 0x73, 0x74, 0x65, 0x61, 0x6d, 0x49, 0x64, 0x55, 0x73, 0x65, 0x72, 0x43, 0x68, 0x61, 0x6e,
+	0x67, 0x65, 0x64, 0x49, 0x73, 0x4c, 0x6f, 0x62, 0x62, 0x79, 0x12, 0x30, 0x0a, 0x13, 0x73, 0x74,
+	0x65, 0x61, 0x6d, 0x49, 0x64, 0x4d, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x43, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x13, 0x73, 0x74, 0x65, 0x61, 0x6d, 0x49, 0x64,
+	0x4d, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x3e, 0x0a, 0x1a,
+	0x73, 0x74, 0x65, 0x61, 0x6d, 0x49, 0x64, 0x4d, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x43, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x49, 0x73, 0x4c, 0x6f, 0x62, 0x62, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x1a, 0x73, 0x74, 0x65, 0x61, 0x6d, 0x49, 0x64, 0x4d, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x43,
+	0x68, 0x61, 0x6e, 0x67, 0x65, 0x49, 0x73, 0x4c, 0x6f, 0x62, 0x62, 0x79, 0x12, 0x6a, 0x0a, 0x15,
 	0x63, 0x68, 0x61, 0x74, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x43,
-	0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x33, 0x0a, 0x09, 0x6c, 0x6f, 0x62, 0x62, 0x79, 0x49, 0x6e,
-	0x66, 0x6f, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x50, 0x61, 0x70, 0x65, 0x72,
-	0x2e, 0x4c, 0x6f, 0x62, 0x62, 0x79, 0x49, 0x6e, 0x66, 0x6f, 0x48, 0x00, 0x52, 0x09, 0x6c, 0x6f,
-	0x62, 0x62, 0x79, 0x49, 0x6e, 0x66, 0x6f, 0x88, 0x01, 0x01, 0x22, 0x62, 0x0a, 0x15, 0x43, 0x68,
-	0x61, 0x74, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x43, 0x68, 0x61,
-	0x6e, 0x67, 0x65, 0x12, 0x08, 0x0a, 0x04, 0x4e, 0x6f, 0x6e, 0x65, 0x10, 0x00, 0x12, 0x0b, 0x0a,
-	0x07, 0x45, 0x6e, 0x74, 0x65, 0x72, 0x65, 0x64, 0x10, 0x01, 0x12, 0x08, 0x0a, 0x04, 0x4c, 0x65,
-	0x66, 0x74, 0x10, 0x02, 0x12, 0x10, 0x0a, 0x0c, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x6e, 0x6e, 0x65,
-	0x63, 0x74, 0x65, 0x64, 0x10, 0x04, 0x12, 0x0a, 0x0a, 0x06, 0x4b, 0x69, 0x63, 0x6b, 0x65, 0x64,
-	0x10, 0x08, 0x12, 0x0a, 0x0a, 0x06, 0x42, 0x61, 0x6e, 0x6e, 0x65, 0x64, 0x10, 0x10, 0x42, 0x0c,
-	0x0a, 0x0a, 0x5f, 0x6c, 0x6f, 0x62, 0x62, 0x79, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0x9c, 0x03, 0x0a,
-	0x11, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x4c, 0x6f, 0x62, 0x62, 0x79, 0x4a, 0x6f,
-	0x69, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x06, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x12, 0x34, 0x0a, 0x15, 0x43, 0x68,
+	0x68, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x34, 0x2e, 0x50, 0x61,
+	0x70, 0x65, 0x72, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x4c, 0x6f, 0x62, 0x62,
+	0x79, 0x43, 0x68, 0x61, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x43, 0x68, 0x61, 0x74,
+	0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x52, 0x15, 0x63, 0x68, 0x61, 0x74, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x53, 0x74, 0x61,
+	0x74, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x33, 0x0a, 0x09, 0x6c, 0x6f, 0x62, 0x62,
+	0x79, 0x49, 0x6e, 0x66, 0x6f, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x50, 0x61,
+	0x70, 0x65, 0x72, 0x2e, 0x4c, 0x6f, 0x62, 0x62, 0x79, 0x49, 0x6e, 0x66, 0x6f, 0x48, 0x00, 0x52,
+	0x09, 0x6c, 0x6f, 0x62, 0x62, 0x79, 0x49, 0x6e, 0x66, 0x6f, 0x88, 0x01, 0x01, 0x22, 0x62, 0x0a,
+	0x15, 0x43, 0x68, 0x61, 0x74, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65,
+	0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x08, 0x0a, 0x04, 0x4e, 0x6f, 0x6e, 0x65, 0x10, 0x00,
+	0x12, 0x0b, 0x0a, 0x07, 0x45, 0x6e, 0x74, 0x65, 0x72, 0x65, 0x64, 0x10, 0x01, 0x12, 0x08, 0x0a,
+	0x04, 0x4c, 0x65, 0x66, 0x74, 0x10, 0x02, 0x12, 0x10, 0x0a, 0x0c, 0x44, 0x69, 0x73, 0x63, 0x6f,
+	0x6e, 0x6e, 0x65, 0x63, 0x74, 0x65, 0x64, 0x10, 0x04, 0x12, 0x0a, 0x0a, 0x06, 0x4b, 0x69, 0x63,
+	0x6b, 0x65, 0x64, 0x10, 0x08, 0x12, 0x0a, 0x0a, 0x06, 0x42, 0x61, 0x6e, 0x6e, 0x65, 0x64, 0x10,
+	0x10, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x6c, 0x6f, 0x62, 0x62, 0x79, 0x49, 0x6e, 0x66, 0x6f, 0x22,
+	0x9c, 0x03, 0x0a, 0x11, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x4c, 0x6f, 0x62, 0x62,
+	0x79, 0x4a, 0x6f, 0x69, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x12, 0x34, 0x0a,
+	0x15, 0x43, 0x68, 0x61, 0x74, 0x52, 0x6f, 0x6f, 0x6d, 0x45, 0x6e, 0x74, 0x65, 0x72, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x15, 0x43, 0x68,
 	0x61, 0x74, 0x52, 0x6f, 0x6f, 0x6d, 0x45, 0x6e, 0x74, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x15, 0x43, 0x68, 0x61, 0x74, 0x52,
-	0x6f, 0x6f, 0x6d, 0x45, 0x6e, 0x74, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x28, 0x0a, 0x0f, 0x43, 0x68, 0x61, 0x74, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69,
-	0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0f, 0x43, 0x68, 0x61, 0x74, 0x50,
-	0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6c, 0x6f,
-	0x62, 0x62, 0x79, 0x49, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x6c, 0x6f, 0x62,
-	0x62, 0x79, 0x49, 0x64, 0x12, 0x24, 0x0a, 0x04, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x05, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x10, 0x2e, 0x50, 0x61, 0x70, 0x65, 0x72, 0x2e, 0x4c, 0x6f, 0x62, 0x62, 0x79,
-	0x49, 0x6e, 0x66, 0x6f, 0x52, 0x04, 0x69, 0x6e, 0x66, 0x6f, 0x22, 0xce, 0x01, 0x0a, 0x16, 0x45,
-	0x43, 0x68, 0x61, 0x74, 0x52, 0x6f, 0x6f, 0x6d, 0x45, 0x6e, 0x74, 0x65, 0x72, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x08, 0x0a, 0x04, 0x4e, 0x6f, 0x6e, 0x65, 0x10, 0x00, 0x12,
-	0x0b, 0x0a, 0x07, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x10, 0x01, 0x12, 0x0f, 0x0a, 0x0b,
-	0x44, 0x6f, 0x65, 0x73, 0x6e, 0x74, 0x45, 0x78, 0x69, 0x73, 0x74, 0x10, 0x02, 0x12, 0x0e, 0x0a,
-	0x0a, 0x4e, 0x6f, 0x74, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x10, 0x03, 0x12, 0x08, 0x0a,
-	0x04, 0x46, 0x75, 0x6c, 0x6c, 0x10, 0x04, 0x12, 0x09, 0x0a, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72,
-	0x10, 0x05, 0x12, 0x0a, 0x0a, 0x06, 0x42, 0x61, 0x6e, 0x6e, 0x65, 0x64, 0x10, 0x06, 0x12, 0x0b,
-	0x0a, 0x07, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x65, 0x64, 0x10, 0x07, 0x12, 0x10, 0x0a, 0x0c, 0x43,
-	0x6c, 0x61, 0x6e, 0x44, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x10, 0x08, 0x12, 0x10, 0x0a,
-	0x0c, 0x43, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x79, 0x42, 0x61, 0x6e, 0x10, 0x09, 0x12,
-	0x14, 0x0a, 0x10, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64,
-	0x59, 0x6f, 0x75, 0x10, 0x0a, 0x12, 0x14, 0x0a, 0x10, 0x59, 0x6f, 0x75, 0x42, 0x6c, 0x6f, 0x63,
-	0x6b, 0x65, 0x64, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x10, 0x0b, 0x22, 0x76, 0x0a, 0x18, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x61, 0x73, 0x4e, 0x65, 0x77, 0x50, 0x32, 0x50,
-	0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x73, 0x74, 0x65, 0x61, 0x6d,
-	0x49, 0x44, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d,
-	0x73, 0x74, 0x65, 0x61, 0x6d, 0x49, 0x44, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x1a, 0x0a,
-	0x08, 0x64, 0x61, 0x74, 0x61, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52,
-	0x08, 0x64, 0x61, 0x74, 0x61, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x68, 0x61,
-	0x6e, 0x6e, 0x65, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x63, 0x68, 0x61, 0x6e,
-	0x6e, 0x65, 0x6c, 0x22, 0x40, 0x0a, 0x18, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x50,
-	0x32, 0x50, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x41, 0x72, 0x72, 0x69, 0x76, 0x65, 0x12,
-	0x24, 0x0a, 0x0d, 0x73, 0x74, 0x65, 0x61, 0x6d, 0x49, 0x44, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x73, 0x74, 0x65, 0x61, 0x6d, 0x49, 0x44, 0x53,
-	0x6f, 0x75, 0x72, 0x63, 0x65, 0x22, 0x9d, 0x02, 0x0a, 0x1b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4d, 0x65,
-	0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x48, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0e, 0x32, 0x34, 0x2e, 0x50, 0x61, 0x70, 0x65, 0x72, 0x2e, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63,
-	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4d, 0x65,
-	0x73, 0x73, 0x61, 0x67, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12,
-	0x15, 0x0a, 0x03, 0x73, 0x74, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x03,
-	0x73, 0x74, 0x72, 0x88, 0x01, 0x01, 0x12, 0x1d, 0x0a, 0x07, 0x63, 0x61, 0x70, 0x74, 0x69, 0x6f,
-	0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x07, 0x63, 0x61, 0x70, 0x74, 0x69,
-	0x6f, 0x6e, 0x88, 0x01, 0x01, 0x22, 0x6a, 0x0a, 0x11, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4d,
-	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1c, 0x0a, 0x18, 0x44, 0x69,
-	0x73, 0x70, 0x6c, 0x61, 0x79, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x41, 0x6e, 0x64, 0x43, 0x6f,
-	0x6e, 0x74, 0x69, 0x6e, 0x75, 0x65, 0x10, 0x00, 0x12, 0x18, 0x0a, 0x14, 0x44, 0x69, 0x73, 0x70,
-	0x6c, 0x61, 0x79, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x41, 0x6e, 0x64, 0x45, 0x78, 0x69, 0x74,
-	0x10, 0x01, 0x12, 0x1d, 0x0a, 0x19, 0x44, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x53, 0x74, 0x72,
-	0x69, 0x6e, 0x67, 0x41, 0x74, 0x4c, 0x6f, 0x67, 0x43, 0x6f, 0x6e, 0x73, 0x6f, 0x6c, 0x65, 0x10,
-	0x02, 0x42, 0x06, 0x0a, 0x04, 0x5f, 0x73, 0x74, 0x72, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x63, 0x61,
-	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x2e, 0x0a, 0x16, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x55, 0x64, 0x70, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12,
-	0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
-	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x4c, 0x0a, 0x16, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x4c, 0x6f, 0x67, 0x43, 0x6f, 0x6e, 0x73, 0x6f, 0x6c, 0x65, 0x43, 0x68, 0x61, 0x74, 0x12,
-	0x18, 0x0a, 0x07, 0x73, 0x74, 0x65, 0x61, 0x6d, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x07, 0x73, 0x74, 0x65, 0x61, 0x6d, 0x69, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73,
-	0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73,
-	0x61, 0x67, 0x65, 0x22, 0x3e, 0x0a, 0x08, 0x55, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x12,
-	0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52,
-	0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x4e,
-	0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x4e,
-	0x61, 0x6d, 0x65, 0x2a, 0x69, 0x0a, 0x0e, 0x55, 0x64, 0x70, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
-	0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x08, 0x0a, 0x04, 0x4e, 0x6f, 0x6e, 0x65, 0x10, 0x00, 0x12,
-	0x12, 0x0a, 0x0e, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x4f, 0x72, 0x59, 0x6f, 0x75, 0x72,
-	0x73, 0x10, 0x10, 0x12, 0x1c, 0x0a, 0x18, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x4f, 0x72,
-	0x59, 0x6f, 0x75, 0x72, 0x73, 0x41, 0x6e, 0x64, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x10,
-	0x20, 0x12, 0x0c, 0x0a, 0x08, 0x50, 0x69, 0x6e, 0x67, 0x50, 0x6f, 0x6e, 0x67, 0x10, 0x30, 0x12,
-	0x0d, 0x0a, 0x09, 0x45, 0x6e, 0x73, 0x75, 0x72, 0x65, 0x50, 0x6b, 0x67, 0x10, 0x40, 0x42, 0x23,
-	0x5a, 0x21, 0x30, 0x78, 0x66, 0x37, 0x2e, 0x74, 0x6f, 0x70, 0x2f, 0x49, 0x73, 0x61, 0x61, 0x63,
-	0x50, 0x61, 0x70, 0x65, 0x72, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f, 0x49, 0x73, 0x61, 0x61,
-	0x63, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x0f, 0x43, 0x68, 0x61, 0x74, 0x50, 0x65, 0x72, 0x6d, 0x69,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0f, 0x43, 0x68,
+	0x61, 0x74, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x18, 0x0a,
+	0x07, 0x6c, 0x6f, 0x62, 0x62, 0x79, 0x49, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07,
+	0x6c, 0x6f, 0x62, 0x62, 0x79, 0x49, 0x64, 0x12, 0x24, 0x0a, 0x04, 0x69, 0x6e, 0x66, 0x6f, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x50, 0x61, 0x70, 0x65, 0x72, 0x2e, 0x4c, 0x6f,
+	0x62, 0x62, 0x79, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x04, 0x69, 0x6e, 0x66, 0x6f, 0x22, 0xce, 0x01,
+	0x0a, 0x16, 0x45, 0x43, 0x68, 0x61, 0x74, 0x52, 0x6f, 0x6f, 0x6d, 0x45, 0x6e, 0x74, 0x65, 0x72,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x08, 0x0a, 0x04, 0x4e, 0x6f, 0x6e, 0x65,
+	0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x10, 0x01, 0x12,
+	0x0f, 0x0a, 0x0b, 0x44, 0x6f, 0x65, 0x73, 0x6e, 0x74, 0x45, 0x78, 0x69, 0x73, 0x74, 0x10, 0x02,
+	0x12, 0x0e, 0x0a, 0x0a, 0x4e, 0x6f, 0x74, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x10, 0x03,
+	0x12, 0x08, 0x0a, 0x04, 0x46, 0x75, 0x6c, 0x6c, 0x10, 0x04, 0x12, 0x09, 0x0a, 0x05, 0x45, 0x72,
+	0x72, 0x6f, 0x72, 0x10, 0x05, 0x12, 0x0a, 0x0a, 0x06, 0x42, 0x61, 0x6e, 0x6e, 0x65, 0x64, 0x10,
+	0x06, 0x12, 0x0b, 0x0a, 0x07, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x65, 0x64, 0x10, 0x07, 0x12, 0x10,
+	0x0a, 0x0c, 0x43, 0x6c, 0x61, 0x6e, 0x44, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x10, 0x08,
+	0x12, 0x10, 0x0a, 0x0c, 0x43, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x79, 0x42, 0x61, 0x6e,
+	0x10, 0x09, 0x12, 0x14, 0x0a, 0x10, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x42, 0x6c, 0x6f, 0x63,
+	0x6b, 0x65, 0x64, 0x59, 0x6f, 0x75, 0x10, 0x0a, 0x12, 0x14, 0x0a, 0x10, 0x59, 0x6f, 0x75, 0x42,
+	0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x10, 0x0b, 0x22, 0x76,
+	0x0a, 0x18, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x61, 0x73, 0x4e, 0x65, 0x77,
+	0x50, 0x32, 0x50, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x73, 0x74,
+	0x65, 0x61, 0x6d, 0x49, 0x44, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x0d, 0x73, 0x74, 0x65, 0x61, 0x6d, 0x49, 0x44, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x12, 0x1a, 0x0a, 0x08, 0x64, 0x61, 0x74, 0x61, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x08, 0x64, 0x61, 0x74, 0x61, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x18, 0x0a, 0x07,
+	0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x63,
+	0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x22, 0x40, 0x0a, 0x18, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x50, 0x32, 0x50, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x41, 0x72, 0x72, 0x69,
+	0x76, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x73, 0x74, 0x65, 0x61, 0x6d, 0x49, 0x44, 0x53, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x73, 0x74, 0x65, 0x61, 0x6d,
+	0x49, 0x44, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x22, 0x9d, 0x02, 0x0a, 0x1b, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x50, 0x75, 0x62, 0x6c, 0x69,
+	0x63, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x48, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x34, 0x2e, 0x50, 0x61, 0x70, 0x65, 0x72, 0x2e, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x50, 0x75, 0x62,
+	0x6c, 0x69, 0x63, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x50, 0x75, 0x62, 0x6c, 0x69,
+	0x63, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79,
+	0x70, 0x65, 0x12, 0x15, 0x0a, 0x03, 0x73, 0x74, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48,
+	0x00, 0x52, 0x03, 0x73, 0x74, 0x72, 0x88, 0x01, 0x01, 0x12, 0x1d, 0x0a, 0x07, 0x63, 0x61, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x07, 0x63, 0x61,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x22, 0x6a, 0x0a, 0x11, 0x50, 0x75, 0x62, 0x6c,
+	0x69, 0x63, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1c, 0x0a,
+	0x18, 0x44, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x41, 0x6e,
+	0x64, 0x43, 0x6f, 0x6e, 0x74, 0x69, 0x6e, 0x75, 0x65, 0x10, 0x00, 0x12, 0x18, 0x0a, 0x14, 0x44,
+	0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x41, 0x6e, 0x64, 0x45,
+	0x78, 0x69, 0x74, 0x10, 0x01, 0x12, 0x1d, 0x0a, 0x19, 0x44, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79,
+	0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x41, 0x74, 0x4c, 0x6f, 0x67, 0x43, 0x6f, 0x6e, 0x73, 0x6f,
+	0x6c, 0x65, 0x10, 0x02, 0x42, 0x06, 0x0a, 0x04, 0x5f, 0x73, 0x74, 0x72, 0x42, 0x0a, 0x0a, 0x08,
+	0x5f, 0x63, 0x61, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x2e, 0x0a, 0x16, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x55, 0x64, 0x70, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x4c, 0x0a, 0x16, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x4c, 0x6f, 0x67, 0x43, 0x6f, 0x6e, 0x73, 0x6f, 0x6c, 0x65, 0x43, 0x68,
+	0x61, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x74, 0x65, 0x61, 0x6d, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x07, 0x73, 0x74, 0x65, 0x61, 0x6d, 0x69, 0x64, 0x12, 0x18, 0x0a, 0x07,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x5c, 0x0a, 0x12, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x47, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x43, 0x68, 0x61, 0x74, 0x12, 0x18, 0x0a, 0x07,
+	0x73, 0x74, 0x65, 0x61, 0x6d, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x73,
+	0x74, 0x65, 0x61, 0x6d, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x22, 0x76, 0x0a, 0x18, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x47, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x43, 0x68, 0x61, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x12, 0x16, 0x0a, 0x06, 0x6a, 0x6f, 0x69, 0x6e, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x06, 0x6a, 0x6f, 0x69, 0x6e, 0x65, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x61, 0x62,
+	0x6c, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c,
+	0x65, 0x64, 0x12, 0x28, 0x0a, 0x0f, 0x73, 0x6c, 0x6f, 0x77, 0x4d, 0x6f, 0x64, 0x65, 0x53, 0x65,
+	0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0f, 0x73, 0x6c, 0x6f,
+	0x77, 0x4d, 0x6f, 0x64, 0x65, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x22, 0x98, 0x02, 0x0a,
+	0x0f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x57, 0x68, 0x69, 0x73, 0x70, 0x65, 0x72,
+	0x12, 0x20, 0x0a, 0x0b, 0x73, 0x74, 0x65, 0x61, 0x6d, 0x69, 0x64, 0x46, 0x72, 0x6f, 0x6d, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x73, 0x74, 0x65, 0x61, 0x6d, 0x69, 0x64, 0x46, 0x72,
+	0x6f, 0x6d, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x74, 0x65, 0x61, 0x6d, 0x49,
+	0x64, 0x54, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x73, 0x74, 0x65, 0x61, 0x6d,
+	0x49, 0x64, 0x54, 0x6f, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x3c,
+	0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x24,
+	0x2e, 0x50, 0x61, 0x70, 0x65, 0x72, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x57,
+	0x68, 0x69, 0x73, 0x70, 0x65, 0x72, 0x2e, 0x57, 0x68, 0x69, 0x73, 0x70, 0x65, 0x72, 0x52, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x59, 0x0a, 0x0d,
+	0x57, 0x68, 0x69, 0x73, 0x70, 0x65, 0x72, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x0d, 0x0a,
+	0x09, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x65, 0x64, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07,
+	0x4f, 0x66, 0x66, 0x6c, 0x69, 0x6e, 0x65, 0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07, 0x42, 0x6c, 0x6f,
+	0x63, 0x6b, 0x65, 0x64, 0x10, 0x02, 0x12, 0x0c, 0x0a, 0x08, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74,
+	0x65, 0x64, 0x10, 0x03, 0x12, 0x11, 0x0a, 0x0d, 0x49, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x54,
+	0x61, 0x72, 0x67, 0x65, 0x74, 0x10, 0x04, 0x22, 0x3e, 0x0a, 0x08, 0x55, 0x73, 0x65, 0x72, 0x49,
+	0x6e, 0x66, 0x6f, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x75,
+	0x73, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x75,
+	0x73, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x2a, 0x4a, 0x0a, 0x0f, 0x4c, 0x6f, 0x62, 0x62, 0x79,
+	0x56, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x0a, 0x0a, 0x06, 0x50, 0x75,
+	0x62, 0x6c, 0x69, 0x63, 0x10, 0x00, 0x12, 0x0f, 0x0a, 0x0b, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64,
+	0x73, 0x4f, 0x6e, 0x6c, 0x79, 0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07, 0x50, 0x72, 0x69, 0x76, 0x61,
+	0x74, 0x65, 0x10, 0x02, 0x12, 0x0d, 0x0a, 0x09, 0x49, 0x6e, 0x76, 0x69, 0x73, 0x69, 0x62, 0x6c,
+	0x65, 0x10, 0x03, 0x2a, 0x43, 0x0a, 0x0d, 0x50, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x63, 0x65, 0x53,
+	0x74, 0x61, 0x74, 0x65, 0x12, 0x0b, 0x0a, 0x07, 0x4f, 0x66, 0x66, 0x6c, 0x69, 0x6e, 0x65, 0x10,
+	0x00, 0x12, 0x0c, 0x0a, 0x08, 0x42, 0x72, 0x6f, 0x77, 0x73, 0x69, 0x6e, 0x67, 0x10, 0x01, 0x12,
+	0x0b, 0x0a, 0x07, 0x49, 0x6e, 0x4c, 0x6f, 0x62, 0x62, 0x79, 0x10, 0x02, 0x12, 0x0a, 0x0a, 0x06,
+	0x49, 0x6e, 0x47, 0x61, 0x6d, 0x65, 0x10, 0x03, 0x2a, 0x7e, 0x0a, 0x0e, 0x55, 0x64, 0x70, 0x4d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x08, 0x0a, 0x04, 0x4e, 0x6f,
+	0x6e, 0x65, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x4f,
+	0x72, 0x59, 0x6f, 0x75, 0x72, 0x73, 0x10, 0x10, 0x12, 0x1c, 0x0a, 0x18, 0x46, 0x6f, 0x72, 0x77,
+	0x61, 0x72, 0x64, 0x4f, 0x72, 0x59, 0x6f, 0x75, 0x72, 0x73, 0x41, 0x6e, 0x64, 0x43, 0x68, 0x61,
+	0x6e, 0x6e, 0x65, 0x6c, 0x10, 0x20, 0x12, 0x0c, 0x0a, 0x08, 0x50, 0x69, 0x6e, 0x67, 0x50, 0x6f,
+	0x6e, 0x67, 0x10, 0x30, 0x12, 0x0d, 0x0a, 0x09, 0x45, 0x6e, 0x73, 0x75, 0x72, 0x65, 0x50, 0x6b,
+	0x67, 0x10, 0x40, 0x12, 0x13, 0x0a, 0x0f, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x45, 0x78,
+	0x74, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x10, 0x50, 0x42, 0x23, 0x5a, 0x21, 0x30, 0x78, 0x66, 0x37,
+	0x2e, 0x74, 0x6f, 0x70, 0x2f, 0x49, 0x73, 0x61, 0x61, 0x63, 0x50, 0x61, 0x70, 0x65, 0x72, 0x53,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x2f, 0x49, 0x73, 0x61, 0x61, 0x63, 0x70, 0x62, 0x62, 0x06, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -2801,70 +6681,149 @@ func file_message_proto_rawDescGZIP() []byte {
 	return file_message_proto_rawDescData
 }
 
-var file_message_proto_enumTypes = make([]protoimpl.EnumInfo, 8)
-var file_message_proto_msgTypes = make([]protoimpl.MessageInfo, 32)
+var file_message_proto_enumTypes = make([]protoimpl.EnumInfo, 18)
+var file_message_proto_msgTypes = make([]protoimpl.MessageInfo, 72)
 var file_message_proto_goTypes = []interface{}{
-	(UdpMessageType)(0),                                // 0: Paper.UdpMessageType
-	(RequestHeader_RequestMessageType)(0),              // 1: Paper.RequestHeader.RequestMessageType
-	(RequestLogin_Lang)(0),                             // 2: Paper.RequestLogin.Lang
-	(RequestSendP2PPackage_EP2PSend)(0),                // 3: Paper.RequestSendP2PPackage.EP2PSend
-	(ResponseHeader_ResponseMessageType)(0),            // 4: Paper.ResponseHeader.ResponseMessageType
-	(ResponseLobbyChatUpdate_ChatMemberStateChange)(0), // 5: Paper.ResponseLobbyChatUpdate.ChatMemberStateChange
-	(ResponseLobbyJoin_EChatRoomEnterResponse)(0),      // 6: Paper.ResponseLobbyJoin.EChatRoomEnterResponse
-	(ResponseServerPublicMessage_PublicMessageType)(0), // 7: Paper.ResponseServerPublicMessage.PublicMessageType
-	(*ProtoVersion)(nil),                               // 8: Paper.ProtoVersion
-	(*RequestHeader)(nil),                              // 9: Paper.RequestHeader
-	(*RequestLobbyList)(nil),                           // 10: Paper.RequestLobbyList
-	(*RequestLogin)(nil),                               // 11: Paper.RequestLogin
-	(*RequestLobbyCreate)(nil),                         // 12: Paper.RequestLobbyCreate
-	(*RequestSetLobbyData)(nil),                        // 13: Paper.RequestSetLobbyData
-	(*RequestSetLobbyMemberData)(nil),                  // 14: Paper.RequestSetLobbyMemberData
-	(*RequestJoinLobby)(nil),                           // 15: Paper.RequestJoinLobby
-	(*RequestSendP2PPackage)(nil),                      // 16: Paper.RequestSendP2PPackage
-	(*RequestLeaveLobby)(nil),                          // 17: Paper.RequestLeaveLobby
-	(*RequestLogConsoleChat)(nil),                      // 18: Paper.RequestLogConsoleChat
-	(*ResponseHeader)(nil),                             // 19: Paper.ResponseHeader
-	(*ResponseLogConsoleChatFastMessage)(nil),          // 20: Paper.ResponseLogConsoleChatFastMessage
-	(*ResponseCreateRoomNameLists)(nil),                // 21: Paper.ResponseCreateRoomNameLists
-	(*ResponseTime)(nil),                               // 22: Paper.ResponseTime
-	(*LobbyInfo)(nil),                                  // 23: Paper.LobbyInfo
-	(*SingleUserDataItem)(nil),                         // 24: Paper.SingleUserDataItem
-	(*SingleUserData)(nil),                             // 25: Paper.SingleUserData
-	(*ResponseUserAddr)(nil),                           // 26: Paper.ResponseUserAddr
-	(*ResponseLobbyList)(nil),                          // 27: Paper.ResponseLobbyList
-	(*ResponseLobbyCreated)(nil),                       // 28: Paper.ResponseLobbyCreated
-	(*ResponseUpdateUserInfo)(nil),                     // 29: Paper.ResponseUpdateUserInfo
-	(*LobbyDataUpdateItem)(nil),                        // 30: Paper.LobbyDataUpdateItem
-	(*ResponseLobbyDataUpdate)(nil),                    // 31: Paper.ResponseLobbyDataUpdate
-	(*ResponseLobbyChatUpdate)(nil),                    // 32: Paper.ResponseLobbyChatUpdate
-	(*ResponseLobbyJoin)(nil),                          // 33: Paper.ResponseLobbyJoin
-	(*ResponseHasNewP2PPackage)(nil),                   // 34: Paper.ResponseHasNewP2PPackage
-	(*ResponseP2PSessionArrive)(nil),                   // 35: Paper.ResponseP2PSessionArrive
-	(*ResponseServerPublicMessage)(nil),                // 36: Paper.ResponseServerPublicMessage
-	(*ResponseServerUdpToken)(nil),                     // 37: Paper.ResponseServerUdpToken
-	(*ResponseLogConsoleChat)(nil),                     // 38: Paper.ResponseLogConsoleChat
-	(*UserInfo)(nil),                                   // 39: Paper.UserInfo
+	(LobbyVisibility)(0),                               // 0: Paper.LobbyVisibility
+	(PresenceState)(0),                                 // 1: Paper.PresenceState
+	(UdpMessageType)(0),                                // 2: Paper.UdpMessageType
+	(RequestHeader_RequestMessageType)(0),              // 3: Paper.RequestHeader.RequestMessageType
+	(RequestLobbyList_LobbyListSort)(0),                // 4: Paper.RequestLobbyList.LobbyListSort
+	(RequestLobbyInviteCode_Action)(0),                 // 5: Paper.RequestLobbyInviteCode.Action
+	(RequestRelation_Action)(0),                        // 6: Paper.RequestRelation.Action
+	(RequestRelation_Kind)(0),                          // 7: Paper.RequestRelation.Kind
+	(LobbyListFilter_Comparison)(0),                    // 8: Paper.LobbyListFilter.Comparison
+	(RequestLogin_Lang)(0),                             // 9: Paper.RequestLogin.Lang
+	(RequestSendP2PPackage_EP2PSend)(0),                // 10: Paper.RequestSendP2PPackage.EP2PSend
+	(ResponseHeader_ResponseMessageType)(0),            // 11: Paper.ResponseHeader.ResponseMessageType
+	(ResponseRequestFailed_Reason)(0),                  // 12: Paper.ResponseRequestFailed.Reason
+	(ResponseMatchmakeStatus_Status)(0),                // 13: Paper.ResponseMatchmakeStatus.Status
+	(ResponseLobbyChatUpdate_ChatMemberStateChange)(0), // 14: Paper.ResponseLobbyChatUpdate.ChatMemberStateChange
+	(ResponseLobbyJoin_EChatRoomEnterResponse)(0),      // 15: Paper.ResponseLobbyJoin.EChatRoomEnterResponse
+	(ResponseServerPublicMessage_PublicMessageType)(0), // 16: Paper.ResponseServerPublicMessage.PublicMessageType
+	(ResponseWhisper_WhisperResult)(0),                 // 17: Paper.ResponseWhisper.WhisperResult
+	(*ProtoVersion)(nil),                               // 18: Paper.ProtoVersion
+	(*RequestHeader)(nil),                              // 19: Paper.RequestHeader
+	(*RequestLobbyList)(nil),                           // 20: Paper.RequestLobbyList
+	(*RequestLobbyListSubscribe)(nil),                  // 21: Paper.RequestLobbyListSubscribe
+	(*RequestMatchmake)(nil),                           // 22: Paper.RequestMatchmake
+	(*RequestLobbyInviteCode)(nil),                     // 23: Paper.RequestLobbyInviteCode
+	(*RequestJoinLobbyByCode)(nil),                     // 24: Paper.RequestJoinLobbyByCode
+	(*RequestUpdateLobbyData)(nil),                     // 25: Paper.RequestUpdateLobbyData
+	(*LobbyDataExpect)(nil),                            // 26: Paper.LobbyDataExpect
+	(*RequestLobbyAnswerJoin)(nil),                     // 27: Paper.RequestLobbyAnswerJoin
+	(*RequestRelation)(nil),                            // 28: Paper.RequestRelation
+	(*RequestPresenceQuery)(nil),                       // 29: Paper.RequestPresenceQuery
+	(*RequestPresenceSubscribe)(nil),                   // 30: Paper.RequestPresenceSubscribe
+	(*RequestJoinPlayer)(nil),                          // 31: Paper.RequestJoinPlayer
+	(*LobbyListFilter)(nil),                            // 32: Paper.LobbyListFilter
+	(*RequestLogin)(nil),                               // 33: Paper.RequestLogin
+	(*RequestLobbyCreate)(nil),                         // 34: Paper.RequestLobbyCreate
+	(*RequestSetLobbyData)(nil),                        // 35: Paper.RequestSetLobbyData
+	(*RequestSetLobbyMemberData)(nil),                  // 36: Paper.RequestSetLobbyMemberData
+	(*RequestJoinLobby)(nil),                           // 37: Paper.RequestJoinLobby
+	(*RequestSendP2PPackage)(nil),                      // 38: Paper.RequestSendP2PPackage
+	(*RequestLeaveLobby)(nil),                          // 39: Paper.RequestLeaveLobby
+	(*RequestLogConsoleChat)(nil),                      // 40: Paper.RequestLogConsoleChat
+	(*RequestGlobalChatJoin)(nil),                      // 41: Paper.RequestGlobalChatJoin
+	(*RequestGlobalChat)(nil),                          // 42: Paper.RequestGlobalChat
+	(*RequestWhisper)(nil),                             // 43: Paper.RequestWhisper
+	(*RequestLobbyKick)(nil),                           // 44: Paper.RequestLobbyKick
+	(*RequestLobbyBan)(nil),                            // 45: Paper.RequestLobbyBan
+	(*RequestLobbySetPassword)(nil),                    // 46: Paper.RequestLobbySetPassword
+	(*RequestLobbySetJoinable)(nil),                    // 47: Paper.RequestLobbySetJoinable
+	(*RequestLobbyTransferOwner)(nil),                  // 48: Paper.RequestLobbyTransferOwner
+	(*RequestLobbySetSpectators)(nil),                  // 49: Paper.RequestLobbySetSpectators
+	(*ResponseHeader)(nil),                             // 50: Paper.ResponseHeader
+	(*ResponseMessageSizeLimits)(nil),                  // 51: Paper.ResponseMessageSizeLimits
+	(*ResponseRequestFailed)(nil),                      // 52: Paper.ResponseRequestFailed
+	(*ResponseUpdateLobbyData)(nil),                    // 53: Paper.ResponseUpdateLobbyData
+	(*ResponseLobbyJoinRequest)(nil),                   // 54: Paper.ResponseLobbyJoinRequest
+	(*ResponseRelationList)(nil),                       // 55: Paper.ResponseRelationList
+	(*RelationEntry)(nil),                              // 56: Paper.RelationEntry
+	(*ResponseFriendPresence)(nil),                     // 57: Paper.ResponseFriendPresence
+	(*PlayerPresence)(nil),                             // 58: Paper.PlayerPresence
+	(*ResponsePresence)(nil),                           // 59: Paper.ResponsePresence
+	(*ResponsePresenceUpdate)(nil),                     // 60: Paper.ResponsePresenceUpdate
+	(*ResponseLobbyInviteCode)(nil),                    // 61: Paper.ResponseLobbyInviteCode
+	(*ResponseMatchmakeStatus)(nil),                    // 62: Paper.ResponseMatchmakeStatus
+	(*ResponseLogConsoleChatFastMessage)(nil),          // 63: Paper.ResponseLogConsoleChatFastMessage
+	(*ResponseCreateRoomNameLists)(nil),                // 64: Paper.ResponseCreateRoomNameLists
+	(*LobbyTemplateInfo)(nil),                          // 65: Paper.LobbyTemplateInfo
+	(*ResponseTime)(nil),                               // 66: Paper.ResponseTime
+	(*LobbyInfo)(nil),                                  // 67: Paper.LobbyInfo
+	(*SingleUserDataItem)(nil),                         // 68: Paper.SingleUserDataItem
+	(*SingleUserData)(nil),                             // 69: Paper.SingleUserData
+	(*ResponseUserAddr)(nil),                           // 70: Paper.ResponseUserAddr
+	(*ResponseLobbyList)(nil),                          // 71: Paper.ResponseLobbyList
+	(*ResponseLobbyListDelta)(nil),                     // 72: Paper.ResponseLobbyListDelta
+	(*LobbyInfoPatch)(nil),                             // 73: Paper.LobbyInfoPatch
+	(*ResponseLobbyCreated)(nil),                       // 74: Paper.ResponseLobbyCreated
+	(*ResponseUpdateUserInfo)(nil),                     // 75: Paper.ResponseUpdateUserInfo
+	(*LobbyDataUpdateItem)(nil),                        // 76: Paper.LobbyDataUpdateItem
+	(*ResponseLobbyDataUpdate)(nil),                    // 77: Paper.ResponseLobbyDataUpdate
+	(*ResponseLobbyOwnerChanged)(nil),                  // 78: Paper.ResponseLobbyOwnerChanged
+	(*ResponseLobbyChatUpdate)(nil),                    // 79: Paper.ResponseLobbyChatUpdate
+	(*ResponseLobbyJoin)(nil),                          // 80: Paper.ResponseLobbyJoin
+	(*ResponseHasNewP2PPackage)(nil),                   // 81: Paper.ResponseHasNewP2PPackage
+	(*ResponseP2PSessionArrive)(nil),                   // 82: Paper.ResponseP2PSessionArrive
+	(*ResponseServerPublicMessage)(nil),                // 83: Paper.ResponseServerPublicMessage
+	(*ResponseServerUdpToken)(nil),                     // 84: Paper.ResponseServerUdpToken
+	(*ResponseLogConsoleChat)(nil),                     // 85: Paper.ResponseLogConsoleChat
+	(*ResponseGlobalChat)(nil),                         // 86: Paper.ResponseGlobalChat
+	(*ResponseGlobalChatStatus)(nil),                   // 87: Paper.ResponseGlobalChatStatus
+	(*ResponseWhisper)(nil),                            // 88: Paper.ResponseWhisper
+	(*UserInfo)(nil),                                   // 89: Paper.UserInfo
 }
 var file_message_proto_depIdxs = []int32{
-	1,  // 0: Paper.RequestHeader.type:type_name -> Paper.RequestHeader.RequestMessageType
-	2,  // 1: Paper.RequestLogin.langCode:type_name -> Paper.RequestLogin.Lang
-	3,  // 2: Paper.RequestSendP2PPackage.p2pSendType:type_name -> Paper.RequestSendP2PPackage.EP2PSend
-	4,  // 3: Paper.ResponseHeader.type:type_name -> Paper.ResponseHeader.ResponseMessageType
-	30, // 4: Paper.LobbyInfo.datas:type_name -> Paper.LobbyDataUpdateItem
-	25, // 5: Paper.LobbyInfo.usersDatas:type_name -> Paper.SingleUserData
-	24, // 6: Paper.SingleUserData.data:type_name -> Paper.SingleUserDataItem
-	23, // 7: Paper.ResponseLobbyList.lobbies:type_name -> Paper.LobbyInfo
-	23, // 8: Paper.ResponseLobbyCreated.info:type_name -> Paper.LobbyInfo
-	30, // 9: Paper.ResponseLobbyDataUpdate.datas:type_name -> Paper.LobbyDataUpdateItem
-	5,  // 10: Paper.ResponseLobbyChatUpdate.chatMemberStateChange:type_name -> Paper.ResponseLobbyChatUpdate.ChatMemberStateChange
-	23, // 11: Paper.ResponseLobbyChatUpdate.lobbyInfo:type_name -> Paper.LobbyInfo
-	23, // 12: Paper.ResponseLobbyJoin.info:type_name -> Paper.LobbyInfo
-	7,  // 13: Paper.ResponseServerPublicMessage.type:type_name -> Paper.ResponseServerPublicMessage.PublicMessageType
-	14, // [14:14] is the sub-list for method output_type
-	14, // [14:14] is the sub-list for method input_type
-	14, // [14:14] is the sub-list for extension type_name
-	14, // [14:14] is the sub-list for extension extendee
-	0,  // [0:14] is the sub-list for field type_name
+	3,  // 0: Paper.RequestHeader.type:type_name -> Paper.RequestHeader.RequestMessageType
+	32, // 1: Paper.RequestLobbyList.filters:type_name -> Paper.LobbyListFilter
+	4,  // 2: Paper.RequestLobbyList.sort:type_name -> Paper.RequestLobbyList.LobbyListSort
+	76, // 3: Paper.RequestMatchmake.modeKeys:type_name -> Paper.LobbyDataUpdateItem
+	9,  // 4: Paper.RequestMatchmake.lang:type_name -> Paper.RequestLogin.Lang
+	5,  // 5: Paper.RequestLobbyInviteCode.action:type_name -> Paper.RequestLobbyInviteCode.Action
+	76, // 6: Paper.RequestUpdateLobbyData.set:type_name -> Paper.LobbyDataUpdateItem
+	26, // 7: Paper.RequestUpdateLobbyData.expect:type_name -> Paper.LobbyDataExpect
+	6,  // 8: Paper.RequestRelation.action:type_name -> Paper.RequestRelation.Action
+	7,  // 9: Paper.RequestRelation.kind:type_name -> Paper.RequestRelation.Kind
+	8,  // 10: Paper.LobbyListFilter.comparison:type_name -> Paper.LobbyListFilter.Comparison
+	9,  // 11: Paper.RequestLogin.langCode:type_name -> Paper.RequestLogin.Lang
+	0,  // 12: Paper.RequestLobbyCreate.visibility:type_name -> Paper.LobbyVisibility
+	10, // 13: Paper.RequestSendP2PPackage.p2pSendType:type_name -> Paper.RequestSendP2PPackage.EP2PSend
+	11, // 14: Paper.ResponseHeader.type:type_name -> Paper.ResponseHeader.ResponseMessageType
+	11, // 15: Paper.ResponseRequestFailed.responseType:type_name -> Paper.ResponseHeader.ResponseMessageType
+	12, // 16: Paper.ResponseRequestFailed.reason:type_name -> Paper.ResponseRequestFailed.Reason
+	76, // 17: Paper.ResponseUpdateLobbyData.current:type_name -> Paper.LobbyDataUpdateItem
+	56, // 18: Paper.ResponseRelationList.friends:type_name -> Paper.RelationEntry
+	56, // 19: Paper.ResponseRelationList.blocks:type_name -> Paper.RelationEntry
+	1,  // 20: Paper.PlayerPresence.state:type_name -> Paper.PresenceState
+	58, // 21: Paper.ResponsePresence.players:type_name -> Paper.PlayerPresence
+	58, // 22: Paper.ResponsePresenceUpdate.players:type_name -> Paper.PlayerPresence
+	13, // 23: Paper.ResponseMatchmakeStatus.status:type_name -> Paper.ResponseMatchmakeStatus.Status
+	65, // 24: Paper.ResponseCreateRoomNameLists.templates:type_name -> Paper.LobbyTemplateInfo
+	0,  // 25: Paper.LobbyTemplateInfo.visibility:type_name -> Paper.LobbyVisibility
+	76, // 26: Paper.LobbyInfo.datas:type_name -> Paper.LobbyDataUpdateItem
+	69, // 27: Paper.LobbyInfo.usersDatas:type_name -> Paper.SingleUserData
+	0,  // 28: Paper.LobbyInfo.visibility:type_name -> Paper.LobbyVisibility
+	68, // 29: Paper.SingleUserData.data:type_name -> Paper.SingleUserDataItem
+	67, // 30: Paper.ResponseLobbyList.lobbies:type_name -> Paper.LobbyInfo
+	67, // 31: Paper.ResponseLobbyListDelta.added:type_name -> Paper.LobbyInfo
+	67, // 32: Paper.ResponseLobbyListDelta.updated:type_name -> Paper.LobbyInfo
+	73, // 33: Paper.ResponseLobbyListDelta.patched:type_name -> Paper.LobbyInfoPatch
+	76, // 34: Paper.LobbyInfoPatch.datas:type_name -> Paper.LobbyDataUpdateItem
+	67, // 35: Paper.ResponseLobbyCreated.info:type_name -> Paper.LobbyInfo
+	76, // 36: Paper.ResponseLobbyDataUpdate.datas:type_name -> Paper.LobbyDataUpdateItem
+	67, // 37: Paper.ResponseLobbyOwnerChanged.lobbyInfo:type_name -> Paper.LobbyInfo
+	14, // 38: Paper.ResponseLobbyChatUpdate.chatMemberStateChange:type_name -> Paper.ResponseLobbyChatUpdate.ChatMemberStateChange
+	67, // 39: Paper.ResponseLobbyChatUpdate.lobbyInfo:type_name -> Paper.LobbyInfo
+	67, // 40: Paper.ResponseLobbyJoin.info:type_name -> Paper.LobbyInfo
+	16, // 41: Paper.ResponseServerPublicMessage.type:type_name -> Paper.ResponseServerPublicMessage.PublicMessageType
+	17, // 42: Paper.ResponseWhisper.result:type_name -> Paper.ResponseWhisper.WhisperResult
+	43, // [43:43] is the sub-list for method output_type
+	43, // [43:43] is the sub-list for method input_type
+	43, // [43:43] is the sub-list for extension type_name
+	43, // [43:43] is the sub-list for extension extendee
+	0,  // [0:43] is the sub-list for field type_name
 }
 
 func init() { file_message_proto_init() }
@@ -2885,8 +6844,248 @@ func file_message_proto_init() {
 				return nil
 			}
 		}
-		file_message_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RequestHeader); i {
+		file_message_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RequestHeader); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_message_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RequestLobbyList); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_message_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RequestLobbyListSubscribe); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_message_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RequestMatchmake); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_message_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RequestLobbyInviteCode); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_message_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RequestJoinLobbyByCode); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_message_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RequestUpdateLobbyData); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_message_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LobbyDataExpect); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_message_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RequestLobbyAnswerJoin); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_message_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RequestRelation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_message_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RequestPresenceQuery); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_message_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RequestPresenceSubscribe); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_message_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RequestJoinPlayer); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_message_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LobbyListFilter); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_message_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RequestLogin); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_message_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RequestLobbyCreate); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_message_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RequestSetLobbyData); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_message_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RequestSetLobbyMemberData); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_message_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RequestJoinLobby); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_message_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RequestSendP2PPackage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_message_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RequestLeaveLobby); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2897,8 +7096,8 @@ func file_message_proto_init() {
 				return nil
 			}
 		}
-		file_message_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RequestLobbyList); i {
+		file_message_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RequestLogConsoleChat); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2909,8 +7108,8 @@ func file_message_proto_init() {
 				return nil
 			}
 		}
-		file_message_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RequestLogin); i {
+		file_message_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RequestGlobalChatJoin); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2921,8 +7120,8 @@ func file_message_proto_init() {
 				return nil
 			}
 		}
-		file_message_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RequestLobbyCreate); i {
+		file_message_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RequestGlobalChat); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2933,8 +7132,8 @@ func file_message_proto_init() {
 				return nil
 			}
 		}
-		file_message_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RequestSetLobbyData); i {
+		file_message_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RequestWhisper); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2945,8 +7144,8 @@ func file_message_proto_init() {
 				return nil
 			}
 		}
-		file_message_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RequestSetLobbyMemberData); i {
+		file_message_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RequestLobbyKick); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2957,8 +7156,8 @@ func file_message_proto_init() {
 				return nil
 			}
 		}
-		file_message_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RequestJoinLobby); i {
+		file_message_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RequestLobbyBan); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2969,8 +7168,8 @@ func file_message_proto_init() {
 				return nil
 			}
 		}
-		file_message_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RequestSendP2PPackage); i {
+		file_message_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RequestLobbySetPassword); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2981,8 +7180,8 @@ func file_message_proto_init() {
 				return nil
 			}
 		}
-		file_message_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RequestLeaveLobby); i {
+		file_message_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RequestLobbySetJoinable); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2993,8 +7192,8 @@ func file_message_proto_init() {
 				return nil
 			}
 		}
-		file_message_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RequestLogConsoleChat); i {
+		file_message_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RequestLobbyTransferOwner); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3005,7 +7204,19 @@ func file_message_proto_init() {
 				return nil
 			}
 		}
-		file_message_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+		file_message_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RequestLobbySetSpectators); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_message_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ResponseHeader); i {
 			case 0:
 				return &v.state
@@ -3017,7 +7228,151 @@ func file_message_proto_init() {
 				return nil
 			}
 		}
-		file_message_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+		file_message_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResponseMessageSizeLimits); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_message_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResponseRequestFailed); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_message_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResponseUpdateLobbyData); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_message_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResponseLobbyJoinRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_message_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResponseRelationList); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_message_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RelationEntry); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_message_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResponseFriendPresence); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_message_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PlayerPresence); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_message_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResponsePresence); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_message_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResponsePresenceUpdate); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_message_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResponseLobbyInviteCode); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_message_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResponseMatchmakeStatus); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_message_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ResponseLogConsoleChatFastMessage); i {
 			case 0:
 				return &v.state
@@ -3029,7 +7384,7 @@ func file_message_proto_init() {
 				return nil
 			}
 		}
-		file_message_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+		file_message_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ResponseCreateRoomNameLists); i {
 			case 0:
 				return &v.state
@@ -3041,7 +7396,19 @@ func file_message_proto_init() {
 				return nil
 			}
 		}
-		file_message_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+		file_message_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LobbyTemplateInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_message_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ResponseTime); i {
 			case 0:
 				return &v.state
@@ -3053,7 +7420,7 @@ func file_message_proto_init() {
 				return nil
 			}
 		}
-		file_message_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+		file_message_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*LobbyInfo); i {
 			case 0:
 				return &v.state
@@ -3065,7 +7432,7 @@ func file_message_proto_init() {
 				return nil
 			}
 		}
-		file_message_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+		file_message_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SingleUserDataItem); i {
 			case 0:
 				return &v.state
@@ -3077,7 +7444,7 @@ func file_message_proto_init() {
 				return nil
 			}
 		}
-		file_message_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+		file_message_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SingleUserData); i {
 			case 0:
 				return &v.state
@@ -3089,7 +7456,7 @@ func file_message_proto_init() {
 				return nil
 			}
 		}
-		file_message_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+		file_message_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ResponseUserAddr); i {
 			case 0:
 				return &v.state
@@ -3101,7 +7468,7 @@ func file_message_proto_init() {
 				return nil
 			}
 		}
-		file_message_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+		file_message_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ResponseLobbyList); i {
 			case 0:
 				return &v.state
@@ -3113,7 +7480,31 @@ func file_message_proto_init() {
 				return nil
 			}
 		}
-		file_message_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+		file_message_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResponseLobbyListDelta); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_message_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LobbyInfoPatch); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_message_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ResponseLobbyCreated); i {
 			case 0:
 				return &v.state
@@ -3125,7 +7516,7 @@ func file_message_proto_init() {
 				return nil
 			}
 		}
-		file_message_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+		file_message_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ResponseUpdateUserInfo); i {
 			case 0:
 				return &v.state
@@ -3137,7 +7528,7 @@ func file_message_proto_init() {
 				return nil
 			}
 		}
-		file_message_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+		file_message_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*LobbyDataUpdateItem); i {
 			case 0:
 				return &v.state
@@ -3149,7 +7540,7 @@ func file_message_proto_init() {
 				return nil
 			}
 		}
-		file_message_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+		file_message_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ResponseLobbyDataUpdate); i {
 			case 0:
 				return &v.state
@@ -3161,7 +7552,19 @@ func file_message_proto_init() {
 				return nil
 			}
 		}
-		file_message_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+		file_message_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResponseLobbyOwnerChanged); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_message_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ResponseLobbyChatUpdate); i {
 			case 0:
 				return &v.state
@@ -3173,7 +7576,7 @@ func file_message_proto_init() {
 				return nil
 			}
 		}
-		file_message_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+		file_message_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ResponseLobbyJoin); i {
 			case 0:
 				return &v.state
@@ -3185,7 +7588,7 @@ func file_message_proto_init() {
 				return nil
 			}
 		}
-		file_message_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+		file_message_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ResponseHasNewP2PPackage); i {
 			case 0:
 				return &v.state
@@ -3197,7 +7600,7 @@ func file_message_proto_init() {
 				return nil
 			}
 		}
-		file_message_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+		file_message_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ResponseP2PSessionArrive); i {
 			case 0:
 				return &v.state
@@ -3209,7 +7612,7 @@ func file_message_proto_init() {
 				return nil
 			}
 		}
-		file_message_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+		file_message_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ResponseServerPublicMessage); i {
 			case 0:
 				return &v.state
@@ -3221,7 +7624,7 @@ func file_message_proto_init() {
 				return nil
 			}
 		}
-		file_message_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+		file_message_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ResponseServerUdpToken); i {
 			case 0:
 				return &v.state
@@ -3233,7 +7636,7 @@ func file_message_proto_init() {
 				return nil
 			}
 		}
-		file_message_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+		file_message_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ResponseLogConsoleChat); i {
 			case 0:
 				return &v.state
@@ -3245,7 +7648,43 @@ func file_message_proto_init() {
 				return nil
 			}
 		}
-		file_message_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+		file_message_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResponseGlobalChat); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_message_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResponseGlobalChatStatus); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_message_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResponseWhisper); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_message_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*UserInfo); i {
 			case 0:
 				return &v.state
@@ -3258,18 +7697,20 @@ func file_message_proto_init() {
 			}
 		}
 	}
-	file_message_proto_msgTypes[4].OneofWrappers = []interface{}{}
-	file_message_proto_msgTypes[7].OneofWrappers = []interface{}{}
-	file_message_proto_msgTypes[15].OneofWrappers = []interface{}{}
-	file_message_proto_msgTypes[24].OneofWrappers = []interface{}{}
+	file_message_proto_msgTypes[13].OneofWrappers = []interface{}{}
+	file_message_proto_msgTypes[16].OneofWrappers = []interface{}{}
+	file_message_proto_msgTypes[19].OneofWrappers = []interface{}{}
 	file_message_proto_msgTypes[28].OneofWrappers = []interface{}{}
+	file_message_proto_msgTypes[49].OneofWrappers = []interface{}{}
+	file_message_proto_msgTypes[61].OneofWrappers = []interface{}{}
+	file_message_proto_msgTypes[65].OneofWrappers = []interface{}{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_message_proto_rawDesc,
-			NumEnums:      8,
-			NumMessages:   32,
+			NumEnums:      18,
+			NumMessages:   72,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -0,0 +1,97 @@
+/*
+	IsaacPaperServer server program
+	Copyright (C) 2024  frto027
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU Affero General Public License as
+	published by the Free Software Foundation, either version 3 of the
+	License, or (at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU Affero General Public License for more details.
+
+	You should have received a copy of the GNU Affero General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package Isaac
+
+import (
+	list2 "container/list"
+	"log"
+	"sync"
+	"time"
+)
+
+type AdminAlert struct {
+	Time time.Time
+	Text string
+}
+
+// the server keeps the latest MaxAdminAlerts alerts for lsalert
+const MaxAdminAlerts = 100
+
+var (
+	adminAlerts      []AdminAlert
+	adminConnections = map[*AdminData]bool{}
+	adminAlertsMutex = sync.Mutex{}
+	// the alerts are written by AdminAlertLoop, the caller doesn't wait for a busy admin
+	adminAlertQueue = make(chan AdminAlert, MaxAdminAlerts)
+)
+
+func registerAdmin(A *AdminData) {
+	adminAlertsMutex.Lock()
+	adminConnections[A] = true
+	adminAlertsMutex.Unlock()
+}
+
+func unregisterAdmin(A *AdminData) {
+	adminAlertsMutex.Lock()
+	delete(adminConnections, A)
+	adminAlertsMutex.Unlock()
+}
+
+// NotifyAdmins logs the alert and queues it for every logged in admin CLI.
+// If the queue is full, the alert can still be read with lsalert.
+func NotifyAdmins(text string) {
+	log.Print("[ALERT] ", text)
+	alert := AdminAlert{Time: time.Now(), Text: text}
+
+	adminAlertsMutex.Lock()
+	adminAlerts = append(adminAlerts, alert)
+	if len(adminAlerts) > MaxAdminAlerts {
+		adminAlerts = adminAlerts[len(adminAlerts)-MaxAdminAlerts:]
+	}
+	adminAlertsMutex.Unlock()
+
+	select {
+	case adminAlertQueue <- alert:
+	default:
+		log.Print("the admin alert queue is full")
+	}
+}
+
+// AdminAlertLoop sends the queued alerts to the admins
+func AdminAlertLoop() {
+	for alert := range adminAlertQueue {
+		sendAdminAlert(alert)
+	}
+}
+
+func sendAdminAlert(alert AdminAlert) {
+	list := list2.New()
+	adminAlertsMutex.Lock()
+	for A := range adminConnections {
+		list.PushBack(A)
+	}
+	adminAlertsMutex.Unlock()
+
+	for it := list.Front(); it != nil; it = it.Next() {
+		A := it.Value.(*AdminData)
+		A.writeMutex.Lock()
+		_ = A.SendPackage("[ALERT] " + alert.Time.Format(time.DateTime) + " " + alert.Text + "\n")
+		A.writeMutex.Unlock()
+	}
+}
@@ -32,6 +32,7 @@ import (
 	"regexp"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 )
 
@@ -48,6 +49,9 @@ type AdminData struct {
 	conn   net.Conn
 	writer *bufio.Writer
 	auth   int
+
+	// the alerts can be sent while a command is running
+	writeMutex sync.Mutex
 }
 
 func (A *AdminData) SendPackage(text string) error {
@@ -79,22 +83,53 @@ info					print current server infos
 time					print the current server time
 lsuser					print user infos
 lslobby					print lobby infos
+lsreport				print the reports sent by users with /report
+lsqueue					print the quick-match queue
+lsalert					print the latest alerts, they are also sent to the admin CLI
 
 log [text]				print [text] to the server's logfile
 exit					kill this connection
 killserver				exit the server process
 
-setroomnames [name1] [name2]...			set room names
+setroomnames [name1] [name2]...			set room names, "{n}" in a name is replaced by a number to make it unique
+lstemplate								print the lobby templates
+settemplate [key] name|group|size|p2p|visibility [value]	create or change a lobby template, visibility is 0 to 3
+settemplate [key] data [k] [v]			set the lobby data of a template, an empty [v] removes it
+deltemplate [key]						remove a lobby template
 setchatbtns	[btn1] [btn2]...			set chat btns
 setfilter [filter_regexp]				set text filter
+setmotd [text]							set the message of the day, empty text clears it
 
 del_old_lobby			delete old empty lobbies
+setlobbyidwindow [hours]	a lobby ID is not used again in [hours] after it is issued
+setcreatelimit [owned] [count] [seconds] [max lobbies] [unjoined ttl]	a user can own [owned] lobbies and create [count] lobbies in [seconds], the server holds [max lobbies], an unjoined lobby is closed after [unjoined ttl] seconds
+lslifecycle				print the lobby lifecycle settings and how many lobbies are closed
+setlifecycle [empty ttl] [max age] [idle timeout] [warning]	set the lobby lifecycle in seconds, 0 disables the max age and the idle timeout, p2p lobbies are never idle unless they use the tcp relay
+lspermanent				print the permanent lobbies, they are never deleted
+addpermanent [key] [group] [size] [name]	define a permanent lobby, it is saved in the data directory, so -d is required
+setpermanent [key] name|group|size|p2p|visibility|password|data [value]	change a permanent lobby, an empty password removes it
+setpermanent [key] data [k] [v]			set the lobby data of a permanent lobby, an empty [v] removes it
+delpermanent [key]		turn a permanent lobby into a normal one
+setlobbysize [default] [max]	set the default and the maximum member count of new lobbies
+setdatalimit [lobby keys] [member keys] [key length] [value length]	set the limits of lobby data and member data
+setreservedkeys [prefix1] [prefix2]...	set the key prefixes only the lobby owner can write
+setcrccheck on|off		reject the users whose game crc is different from the lobby
+lsjoincheck				print the checks a user must pass to join a lobby
+
+setmsgsize [frame] [request]	set the biggest frame the server sends and the biggest request it accepts
+setmsglimit [type] [bytes]		set the biggest response of a message type, [type] can be "default"
 
 public					set server mode to public
 private					set server mode to private
 allow [steamid]
 deny  [steamid] [reason]
 kick  [steamid] [reason]
+
+mute   [steamid] [minutes]			mute the user in all chats
+unmute [steamid]
+setchatlimit [count] [seconds]		allow [count] chat messages in [seconds], 0 means no limit
+globalchat on|off					enable or disable the global chat channel
+globalchat slow [seconds]			global chat users can send one message every [seconds], 0 turns it off
 `)
 		}
 	case "info":
@@ -104,11 +139,25 @@ kick  [steamid] [reason]
 		lobbiesMutex.Lock()
 		lobbyCount := len(lobbies)
 		lobbiesMutex.Unlock()
+		lobbyListCacheMutex.Lock()
+		subscriberCount := len(lobbyListSubscribers)
+		lobbyListCacheMutex.Unlock()
+		matchmakeQueueMutex.Lock()
+		queueSize := len(matchmakeQueue)
+		matchmakeQueueMutex.Unlock()
+		friendCount, blockCount := RelationCount()
+		presenceSubscribersMutex.Lock()
+		presenceSubscriberCount := len(presenceSubscribers)
+		presenceSubscribersMutex.Unlock()
 
 		str := fmt.Sprint(
 			"Protocol version:\t", PROTOCOL_VER, "\n",
 			"session count:\t", sessionCount, "\n",
 			"lobby count:\t", lobbyCount, "\n",
+			"lobby list subscribers:\t", subscriberCount, "\n",
+			"matchmake queue:\t", queueSize, "\n",
+			"friends/blocks:\t", friendCount, "/", blockCount, "\n",
+			"presence subscribers:\t", presenceSubscriberCount, "\n",
 		)
 
 		DefaultLobbyNamesMutex.Lock()
@@ -146,6 +195,34 @@ kick  [steamid] [reason]
 		userAccessMutex.Unlock()
 		str += "\n"
 
+		ChatRateLimitMutex.Lock()
+		str += fmt.Sprint("chat rate limit: ", ChatRateLimitCount, " messages in ", ChatRateLimitWindow, "\n")
+		ChatRateLimitMutex.Unlock()
+
+		MessageSizeMutex.Lock()
+		str += fmt.Sprint("message size: frame ", MaxMessageSize, ", request ", MaxRequestSize,
+			", response ", DefaultResponseSizeLimit)
+		for t, limit := range ResponseSizeLimits {
+			str += fmt.Sprint(", ", t, " ", limit)
+		}
+		str += "\n"
+		MessageSizeMutex.Unlock()
+
+		LobbyMembersMutex.Lock()
+		str += fmt.Sprint("lobby size: default ", DefaultLobbyMembers, ", max ", MaxLobbyMembers, "\n")
+		LobbyMembersMutex.Unlock()
+
+		DataQuotaMutex.Lock()
+		str += fmt.Sprint("lobby data: ", MaxLobbyDataKeys, " keys, member data: ", MaxMemberDataKeys,
+			" keys, key length ", MaxDataKeyLength, ", value length ", MaxDataValueLength,
+			", reserved prefixes ", ReservedDataKeyPrefixes, "\n")
+		DataQuotaMutex.Unlock()
+
+		globalChatMutex.Lock()
+		str += fmt.Sprint("global chat: enabled ", globalChatEnabled, ", slow mode ", globalChatSlowMode,
+			", ", len(globalChatMembers), " users joined\n")
+		globalChatMutex.Unlock()
+
 		_ = A.SendPackage(str)
 
 	case "killserver":
@@ -177,17 +254,34 @@ kick  [steamid] [reason]
 		for _, L := range lobbies {
 			PASSWORD := "no-password"
 			if L.password != nil {
-				PASSWORD = "PASSWORD: '" + *L.password + "'"
+				PASSWORD = "has-password"
 			}
 			P2P := "p2p-disable"
 			if L.enableP2P {
 				P2P = "p2p-enable"
 			}
-			_, _ = A.writer.WriteString(fmt.Sprintf("% 4d  ID:%d NAME: '%s' %s %s", i, L.id, L.name, PASSWORD, P2P))
+			JOINABLE := "joinable"
+			if !L.joinable {
+				JOINABLE = "not-joinable"
+			}
+			inviteCodesMutex.Lock()
+			INVITE := L.inviteCode
+			inviteCodesMutex.Unlock()
+			if INVITE == "" {
+				INVITE = "-"
+			}
+			PERMANENT := "-"
+			permanentLobbiesMutex.Lock()
+			if L.permanent != nil {
+				PERMANENT = L.permanent.Key
+			}
+			permanentLobbiesMutex.Unlock()
+			_, _ = A.writer.WriteString(fmt.Sprintf("% 4d  ID:%d NAME: '%s' %s %s %s %v bans:%d members:%d/%d spectators:%d group:%d invite:%s permanent:%s",
+				i, L.id, L.name, PASSWORD, P2P, JOINABLE, L.visibility, len(L.bans), L.UserCount(), len(L.users), len(L.spectators), L.group, INVITE, PERMANENT))
 			i += 1
 			_, _ = A.writer.WriteString("(")
-			for j := 0; j < 4; j++ {
-				_, _ = A.writer.WriteString(fmt.Sprint(L.users[j], " "))
+			for _, u := range L.users {
+				_, _ = A.writer.WriteString(fmt.Sprint(u, " "))
 			}
 			_, _ = A.writer.WriteString(")\n")
 		}
@@ -226,19 +320,413 @@ kick  [steamid] [reason]
 		names := strings.Split(args, " ")
 		DefaultLobbyNames = &names
 		DefaultLobbyNamesMutex.Unlock()
+	case "lstemplate":
+		for _, key := range lobbyTemplateKeys() {
+			if T, ok := FindLobbyTemplate(key); ok {
+				_, _ = A.writer.WriteString(fmt.Sprintf("%s  NAME: '%s' p2p:%v group:%d size:%d %v data:%v\n",
+					key, T.Name, T.EnableP2P, T.Group, LobbyCapacity(T.Capacity), T.Visibility, T.Data))
+			}
+		}
+		_, _ = A.writer.WriteString("--End Of List--\n")
+		_ = A.writer.WriteByte(0)
+		_ = A.writer.Flush()
+	case "settemplate":
+		argss := strings.SplitN(args, " ", 3)
+		if len(argss) < 2 || argss[0] == "" {
+			_ = A.SendPackage("usage: settemplate [key] name|group|size|p2p|visibility|data [value]")
+			return
+		}
+		value := ""
+		if len(argss) == 3 {
+			value = argss[2]
+		}
+		err := EditLobbyTemplate(argss[0], func(T *LobbyTemplate) error {
+			switch argss[1] {
+			case "name":
+				T.Name = value
+			case "group":
+				group, err := strconv.ParseInt(value, 10, 32)
+				if err != nil {
+					return errors.New("invalid group")
+				}
+				T.Group = int32(group)
+			case "size":
+				size, err := strconv.Atoi(value)
+				if err != nil || size < 0 {
+					return errors.New("invalid size")
+				}
+				T.Capacity = size
+			case "p2p":
+				if value != "on" && value != "off" {
+					return errors.New("usage: settemplate [key] p2p on|off")
+				}
+				T.EnableP2P = value == "on"
+			case "visibility":
+				visibility, err := strconv.Atoi(value)
+				if _, known := Isaacpb.LobbyVisibility_name[int32(visibility)]; err != nil || !known {
+					return errors.New("invalid visibility")
+				}
+				T.Visibility = Isaacpb.LobbyVisibility(visibility)
+			case "data":
+				kv := strings.SplitN(value, " ", 2)
+				if kv[0] == "" {
+					return errors.New("usage: settemplate [key] data [k] [v]")
+				}
+				if len(kv) == 1 || kv[1] == "" {
+					delete(T.Data, kv[0])
+				} else {
+					T.Data[kv[0]] = kv[1]
+				}
+			default:
+				return errors.New("unknown field " + argss[1])
+			}
+			return nil
+		})
+		if err != nil {
+			_ = A.SendPackage(err.Error())
+			return
+		}
+		_ = A.SendPackage("success")
+	case "deltemplate":
+		if err := RemoveLobbyTemplate(args); err != nil {
+			_ = A.SendPackage(err.Error())
+			return
+		}
+		_ = A.SendPackage("success")
 	case "setchatbtns":
 		DefaultFastChatMessagesMutex.Lock()
 		btns := strings.Split(args, " ")
 		DefaultFastChatMessages = &btns
 		DefaultFastChatMessagesMutex.Unlock()
+	case "setmotd":
+		MessageOfTheDayMutex.Lock()
+		if len(args) == 0 {
+			MessageOfTheDay = nil
+		} else {
+			motd := strings.ReplaceAll(args, "\\n", "\n")
+			MessageOfTheDay = &motd
+		}
+		MessageOfTheDayMutex.Unlock()
+	case "lsreport":
+		userReportsMutex.Lock()
+		for i, r := range userReports {
+			_, _ = A.writer.WriteString(fmt.Sprintf("% 4d  %s %d -> %d lobby %d: %s\n",
+				i, r.Time.Format(time.DateTime), r.Reporter, r.Target, r.Lobby, r.Reason))
+		}
+		userReportsMutex.Unlock()
+		_, _ = A.writer.WriteString("--End Of List--\n")
+		_ = A.writer.WriteByte(0)
+		_ = A.writer.Flush()
+	case "lsalert":
+		adminAlertsMutex.Lock()
+		for _, a := range adminAlerts {
+			_, _ = A.writer.WriteString(fmt.Sprint(a.Time.Format(time.DateTime), " ", a.Text, "\n"))
+		}
+		adminAlertsMutex.Unlock()
+		_, _ = A.writer.WriteString("--End Of List--\n")
+		_ = A.writer.WriteByte(0)
+		_ = A.writer.Flush()
+	case "lsqueue":
+		matchmakeQueueMutex.Lock()
+		for i, t := range matchmakeQueue {
+			_, _ = A.writer.WriteString(fmt.Sprintf("% 4d  %s(%d) group %d size %d lang %v(any:%v) waits %v modes %v\n",
+				i+1, t.session.name, t.session.steamId, t.group, t.size, t.lang, t.anyLang,
+				time.Since(t.since).Round(time.Second), t.modeKeys))
+		}
+		matchmakeQueueMutex.Unlock()
+		_, _ = A.writer.WriteString("--End Of List--\n")
+		_ = A.writer.WriteByte(0)
+		_ = A.writer.Flush()
 	case "setfilter":
 		TextFilterReMutex.Lock()
 		if re, err := regexp.Compile(args); err == nil {
 			TextFilterRe = re
 		}
 		TextFilterReMutex.Unlock()
+	case "setlobbysize":
+		argss := strings.Split(args, " ")
+		if len(argss) != 2 {
+			_ = A.SendPackage("usage: setlobbysize [default] [max]")
+			return
+		}
+		defSize, err1 := strconv.Atoi(argss[0])
+		maxSize, err2 := strconv.Atoi(argss[1])
+		// the udp forwarder addresses the members with one byte
+		if err1 != nil || err2 != nil || defSize < 1 || maxSize < defSize || maxSize > 256 {
+			_ = A.SendPackage("invalid arguments, 1 <= default <= max <= 256")
+			return
+		}
+		LobbyMembersMutex.Lock()
+		DefaultLobbyMembers = defSize
+		MaxLobbyMembers = maxSize
+		LobbyMembersMutex.Unlock()
+		_ = A.SendPackage("success")
+	case "setdatalimit":
+		argss := strings.Split(args, " ")
+		if len(argss) != 4 {
+			_ = A.SendPackage("usage: setdatalimit [lobby keys] [member keys] [key length] [value length]")
+			return
+		}
+		limits := make([]int, len(argss))
+		for i, arg := range argss {
+			n, err := strconv.Atoi(arg)
+			if err != nil || n < 1 {
+				_ = A.SendPackage("invalid arguments, the limits must be positive")
+				return
+			}
+			limits[i] = n
+		}
+		DataQuotaMutex.Lock()
+		MaxLobbyDataKeys, MaxMemberDataKeys, MaxDataKeyLength, MaxDataValueLength = limits[0], limits[1], limits[2], limits[3]
+		DataQuotaMutex.Unlock()
+		_ = A.SendPackage("success")
+	case "setcrccheck":
+		if args != "on" && args != "off" {
+			_ = A.SendPackage("usage: setcrccheck on|off")
+			return
+		}
+		JoinCheckMutex.Lock()
+		JoinRequireSameGameCrc = args == "on"
+		JoinCheckMutex.Unlock()
+		_ = A.SendPackage("success")
+	case "lsjoincheck":
+		joinChecksMutex.Lock()
+		for i, check := range joinChecks {
+			_, _ = A.writer.WriteString(fmt.Sprintf("% 4d  %s\n", i, check.Name()))
+		}
+		joinChecksMutex.Unlock()
+		_, _ = A.writer.WriteString("--End Of List--\n")
+		_ = A.writer.WriteByte(0)
+		_ = A.writer.Flush()
+	case "setreservedkeys":
+		prefixes := []string{}
+		for _, prefix := range strings.Split(args, " ") {
+			if len(prefix) > 0 {
+				prefixes = append(prefixes, prefix)
+			}
+		}
+		DataQuotaMutex.Lock()
+		ReservedDataKeyPrefixes = prefixes
+		DataQuotaMutex.Unlock()
+		_ = A.SendPackage("success")
+	case "setmsgsize":
+		argss := strings.Split(args, " ")
+		if len(argss) != 2 {
+			_ = A.SendPackage("usage: setmsgsize [frame] [request]")
+			return
+		}
+		frame, err1 := strconv.Atoi(argss[0])
+		request, err2 := strconv.Atoi(argss[1])
+		if err1 != nil || err2 != nil || frame < LegacyMessageSize || request < LegacyMessageSize {
+			_ = A.SendPackage(fmt.Sprint("invalid arguments, the sizes can't be less than ", LegacyMessageSize))
+			return
+		}
+		MessageSizeMutex.Lock()
+		MaxMessageSize = frame
+		MaxRequestSize = request
+		MessageSizeMutex.Unlock()
+		_ = A.SendPackage("success")
+	case "setmsglimit":
+		argss := strings.Split(args, " ")
+		if len(argss) != 2 {
+			_ = A.SendPackage("usage: setmsglimit [type] [bytes]")
+			return
+		}
+		limit, err := strconv.Atoi(argss[1])
+		if err != nil || limit <= 0 {
+			_ = A.SendPackage("invalid size")
+			return
+		}
+		if argss[0] == "default" {
+			MessageSizeMutex.Lock()
+			DefaultResponseSizeLimit = limit
+			MessageSizeMutex.Unlock()
+			_ = A.SendPackage("success")
+			return
+		}
+		t, ok := Isaacpb.ResponseHeader_ResponseMessageType_value[argss[0]]
+		if !ok {
+			_ = A.SendPackage("unknown message type " + argss[0])
+			return
+		}
+		MessageSizeMutex.Lock()
+		ResponseSizeLimits[Isaacpb.ResponseHeader_ResponseMessageType(t)] = limit
+		MessageSizeMutex.Unlock()
+		_ = A.SendPackage("success")
 	case "del_old_lobby":
 		_ = A.SendPackage(fmt.Sprint("Delete ", DeleteOldLobbies(), "lobbies"))
+	case "setlobbyidwindow":
+		hours, err := strconv.Atoi(args)
+		if err != nil || hours < 0 {
+			_ = A.SendPackage("usage: setlobbyidwindow [hours]")
+			return
+		}
+		LobbyIDMutex.Lock()
+		LobbyIDReuseWindow = time.Duration(hours) * time.Hour
+		LobbyIDMutex.Unlock()
+		_ = A.SendPackage(fmt.Sprint("success, ", IssuedLobbyIDCount(), " IDs are reserved now"))
+	case "setcreatelimit":
+		argss := strings.Split(args, " ")
+		if len(argss) != 5 {
+			_ = A.SendPackage("usage: setcreatelimit [owned] [count] [seconds] [max lobbies] [unjoined ttl]")
+			return
+		}
+		limits := make([]int, len(argss))
+		for i, arg := range argss {
+			n, err := strconv.Atoi(arg)
+			if err != nil || n < 1 {
+				_ = A.SendPackage("invalid arguments, the limits must be positive")
+				return
+			}
+			limits[i] = n
+		}
+		LobbyCreateLimitMutex.Lock()
+		MaxOwnedLobbies, LobbyCreateLimit, MaxLobbies = limits[0], limits[1], limits[3]
+		LobbyCreateWindow, UnjoinedLobbyTTL = time.Duration(limits[2])*time.Second, time.Duration(limits[4])*time.Second
+		LobbyCreateLimitMutex.Unlock()
+		_ = A.SendPackage("success")
+	case "lslifecycle":
+		LobbyLifecycleMutex.Lock()
+		str := fmt.Sprint(
+			"empty lobby ttl:\t", EmptyLobbyTTL, "\n",
+			"max lobby age:\t", MaxLobbyAge, "\n",
+			"idle timeout:\t", LobbyIdleTimeout, "\n",
+			"close warning:\t", LobbyCloseWarning, "\n",
+		)
+		LobbyLifecycleMutex.Unlock()
+		LobbyCreateLimitMutex.Lock()
+		str += fmt.Sprint(
+			"max owned lobbies:\t", MaxOwnedLobbies, "\n",
+			"create limit:\t", LobbyCreateLimit, " in ", LobbyCreateWindow, "\n",
+			"max lobbies:\t", MaxLobbies, "\n",
+			"unjoined lobby ttl:\t", UnjoinedLobbyTTL, "\n",
+		)
+		LobbyCreateLimitMutex.Unlock()
+		stats := GetLobbyLifecycleStats()
+		str += fmt.Sprint(
+			"empty deleted:\t", stats.EmptyDeleted, "\n",
+			"unjoined closed:\t", stats.UnjoinedClosed, "\n",
+			"closed by age:\t", stats.AgeClosed, "\n",
+			"closed by idle:\t", stats.IdleClosed, "\n",
+			"warned:\t", stats.Warned, "\n",
+			"last run:\t", stats.LastRun.Format(time.DateTime), "\n",
+		)
+		_ = A.SendPackage(str)
+	case "setlifecycle":
+		argss := strings.Split(args, " ")
+		if len(argss) != 4 {
+			_ = A.SendPackage("usage: setlifecycle [empty ttl] [max age] [idle timeout] [warning]")
+			return
+		}
+		durations := make([]time.Duration, len(argss))
+		for i, arg := range argss {
+			n, err := strconv.Atoi(arg)
+			if err != nil || n < 0 {
+				_ = A.SendPackage("invalid arguments, the seconds must not be negative")
+				return
+			}
+			durations[i] = time.Duration(n) * time.Second
+		}
+		LobbyLifecycleMutex.Lock()
+		EmptyLobbyTTL, MaxLobbyAge, LobbyIdleTimeout, LobbyCloseWarning = durations[0], durations[1], durations[2], durations[3]
+		LobbyLifecycleMutex.Unlock()
+		_ = A.SendPackage("success")
+	case "lspermanent":
+		permanentLobbiesMutex.Lock()
+		for _, P := range permanentLobbies {
+			PASSWORD := "no-password"
+			if P.password != nil {
+				PASSWORD = "has-password"
+			}
+			_, _ = A.writer.WriteString(fmt.Sprintf("%s  ID:%d NAME: '%s' %s p2p:%v group:%d size:%d data:%v\n",
+				P.Key, P.lobby, P.Name, PASSWORD, P.EnableP2P, P.Group, LobbyCapacity(P.Capacity), P.Data))
+		}
+		permanentLobbiesMutex.Unlock()
+		_, _ = A.writer.WriteString("--End Of List--\n")
+		_ = A.writer.WriteByte(0)
+		_ = A.writer.Flush()
+	case "addpermanent":
+		argss := strings.SplitN(args, " ", 4)
+		if len(argss) != 4 {
+			_ = A.SendPackage("usage: addpermanent [key] [group] [size] [name]")
+			return
+		}
+		group, err1 := strconv.ParseInt(argss[1], 10, 32)
+		size, err2 := strconv.Atoi(argss[2])
+		if err1 != nil || err2 != nil || size < 0 {
+			_ = A.SendPackage("invalid group or size")
+			return
+		}
+		if err := AddPermanentLobby(argss[0], int32(group), size, argss[3]); err != nil {
+			_ = A.SendPackage(err.Error())
+			return
+		}
+		_ = A.SendPackage("success")
+	case "setpermanent":
+		argss := strings.SplitN(args, " ", 3)
+		if len(argss) < 2 {
+			_ = A.SendPackage("usage: setpermanent [key] name|group|size|p2p|visibility|password|data [value]")
+			return
+		}
+		value := ""
+		if len(argss) == 3 {
+			value = argss[2]
+		}
+		err := EditPermanentLobby(argss[0], func(P *PermanentLobby) error {
+			switch argss[1] {
+			case "name":
+				P.Name = value
+			case "group":
+				group, err := strconv.ParseInt(value, 10, 32)
+				if err != nil {
+					return errors.New("invalid group")
+				}
+				P.Group = int32(group)
+			case "size":
+				size, err := strconv.Atoi(value)
+				if err != nil || size < 0 {
+					return errors.New("invalid size")
+				}
+				P.Capacity = size
+			case "p2p":
+				if value != "on" && value != "off" {
+					return errors.New("usage: setpermanent [key] p2p on|off")
+				}
+				P.EnableP2P = value == "on"
+			case "visibility":
+				visibility, err := strconv.Atoi(value)
+				if _, known := Isaacpb.LobbyVisibility_name[int32(visibility)]; err != nil || !known {
+					return errors.New("invalid visibility")
+				}
+				P.Visibility = Isaacpb.LobbyVisibility(visibility)
+			case "password":
+				P.Password, P.PasswordHash = value, ""
+			case "data":
+				kv := strings.SplitN(value, " ", 2)
+				if kv[0] == "" {
+					return errors.New("usage: setpermanent [key] data [k] [v]")
+				}
+				if len(kv) == 1 || kv[1] == "" {
+					delete(P.Data, kv[0])
+				} else {
+					P.Data[kv[0]] = kv[1]
+				}
+			default:
+				return errors.New("unknown field " + argss[1])
+			}
+			return nil
+		})
+		if err != nil {
+			_ = A.SendPackage(err.Error())
+			return
+		}
+		_ = A.SendPackage("success, the lobby follows the change when it is empty")
+	case "delpermanent":
+		if err := RemovePermanentLobby(args); err != nil {
+			_ = A.SendPackage(err.Error())
+			return
+		}
+		_ = A.SendPackage("success")
 	case "exit":
 		_ = A.conn.Close()
 	case "public":
@@ -301,6 +789,78 @@ kick  [steamid] [reason]
 			Str:     &reason,
 			Caption: &caption,
 		})
+	case "mute":
+		argss := strings.SplitN(args, " ", 2)
+		id, err := strconv.ParseInt(argss[0], 10, 64)
+		if err != nil {
+			_ = A.SendPackage(fmt.Sprint("failed to convert steam ID:", err))
+			return
+		}
+		minutes := int64(60)
+		if len(argss) == 2 {
+			minutes, err = strconv.ParseInt(argss[1], 10, 64)
+			if err != nil {
+				_ = A.SendPackage(fmt.Sprint("failed to convert minutes:", err))
+				return
+			}
+		}
+		until := time.Now().Add(time.Duration(minutes) * time.Minute)
+		MuteUser(SteamID(id), until)
+		log.Print("user ", id, " is muted until ", until)
+		_ = A.SendPackage(fmt.Sprint("user ", id, " is muted until ", until))
+	case "unmute":
+		id, err := strconv.ParseInt(args, 10, 64)
+		if err != nil {
+			_ = A.SendPackage(fmt.Sprint("failed to convert steam ID:", err))
+			return
+		}
+		UnmuteUser(SteamID(id))
+		_ = A.SendPackage("success")
+	case "setchatlimit":
+		argss := strings.Split(args, " ")
+		if len(argss) != 2 {
+			_ = A.SendPackage("usage: setchatlimit [count] [seconds]")
+			return
+		}
+		count, err1 := strconv.Atoi(argss[0])
+		seconds, err2 := strconv.Atoi(argss[1])
+		if err1 != nil || err2 != nil || count < 0 || seconds <= 0 {
+			_ = A.SendPackage("invalid arguments")
+			return
+		}
+		ChatRateLimitMutex.Lock()
+		ChatRateLimitCount = count
+		ChatRateLimitWindow = time.Duration(seconds) * time.Second
+		ChatRateLimitMutex.Unlock()
+		_ = A.SendPackage("success")
+	case "globalchat":
+		argss := strings.Split(args, " ")
+		globalChatMutex.Lock()
+		enabled, slowMode := globalChatEnabled, globalChatSlowMode
+		globalChatMutex.Unlock()
+		switch argss[0] {
+		case "on":
+			enabled = true
+		case "off":
+			enabled = false
+		case "slow":
+			if len(argss) != 2 {
+				_ = A.SendPackage("usage: globalchat slow [seconds]")
+				return
+			}
+			seconds, err := strconv.Atoi(argss[1])
+			if err != nil || seconds < 0 {
+				_ = A.SendPackage("invalid seconds")
+				return
+			}
+			slowMode = time.Duration(seconds) * time.Second
+		default:
+			_ = A.SendPackage("usage: globalchat on|off|slow [seconds]")
+			return
+		}
+		SetGlobalChat(enabled, slowMode)
+		log.Print("global chat is set to enabled ", enabled, ", slow mode ", slowMode)
+		_ = A.SendPackage("success")
 	}
 }
 
@@ -313,12 +873,16 @@ func (A *AdminData) HandleCommand(text string) error {
 			_ = A.SendPackage(`Welcome to the admin CLI of IsaacPaperServer!
 You are authorized as admin. type "help" to display more information. 
 `)
+			registerAdmin(A)
 			return nil
 		}
 
 		return errors.New(fmt.Sprint("user is not auth, from ", A.conn.RemoteAddr()))
 	}
 
+	A.writeMutex.Lock()
+	defer A.writeMutex.Unlock()
+
 	cmd := strings.SplitN(text, " ", 2)
 	switch len(cmd) {
 	case 1:
@@ -336,6 +900,8 @@ func HandleAdminSession(s *SessionData) {
 		auth:   UserAuth_NotLogin,
 	}
 
+	defer unregisterAdmin(&data)
+
 	reader := bufio.NewReader(data.conn)
 	for {
 		s, err := reader.ReadString(0)
@@ -0,0 +1,277 @@
+/*
+	IsaacPaperServer server program
+	Copyright (C) 2024  frto027
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU Affero General Public License as
+	published by the Free Software Foundation, either version 3 of the
+	License, or (at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU Affero General Public License for more details.
+
+	You should have received a copy of the GNU Affero General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package Isaac
+
+import (
+	"IsaacPaperServer/0xf7.top/IsaacPaperServer/Isaacpb"
+	list2 "container/list"
+	"fmt"
+	"log"
+	"sync"
+	"time"
+)
+
+var (
+	// a user can send at most ChatRateLimitCount messages in ChatRateLimitWindow
+	ChatRateLimitCount  = 5
+	ChatRateLimitWindow = time.Second * 10
+	ChatRateLimitMutex  = sync.Mutex{}
+
+	chatMutes      = map[SteamID]time.Time{}
+	chatMutesMutex = sync.Mutex{}
+
+	globalChatEnabled  = true
+	globalChatSlowMode = time.Duration(0)
+	globalChatMembers  = map[SteamID]*SessionData{}
+	globalChatMutex    = sync.Mutex{}
+)
+
+func MuteUser(id SteamID, until time.Time) {
+	chatMutesMutex.Lock()
+	chatMutes[id] = until
+	chatMutesMutex.Unlock()
+}
+
+func UnmuteUser(id SteamID) {
+	chatMutesMutex.Lock()
+	delete(chatMutes, id)
+	chatMutesMutex.Unlock()
+}
+
+func isMuted(id SteamID) (time.Time, bool) {
+	chatMutesMutex.Lock()
+	defer chatMutesMutex.Unlock()
+	until, ok := chatMutes[id]
+	if !ok {
+		return until, false
+	}
+	if time.Now().After(until) {
+		delete(chatMutes, id)
+		return until, false
+	}
+	return until, true
+}
+
+func (s *SessionData) SendConsoleMessage(caption string, str string) bool {
+	return s.SendPackage(Isaacpb.ResponseHeader_ServerPublicMessage, 0, &Isaacpb.ResponseServerPublicMessage{
+		Type:    Isaacpb.ResponseServerPublicMessage_DisplayStringAtLogConsole,
+		Str:     &str,
+		Caption: &caption,
+	})
+}
+
+// localized picks the text matching the user's language
+func (s *SessionData) localized(en string, zh string) string {
+	if s.langId == Isaacpb.RequestLogin_ZH {
+		return zh
+	}
+	return en
+}
+
+// prepareChat is the pipeline every chat message goes through before it is sent to anyone.
+// It returns the filtered message, or false if the message should be dropped, in which case
+// the user has been told why.
+func (s *SessionData) prepareChat(message string) (string, bool) {
+	if len(message) == 0 {
+		return "", false
+	}
+
+	if until, muted := isMuted(s.steamId); muted {
+		s.SendConsoleMessage(s.localized("Chat", "聊天"), s.localized(
+			fmt.Sprint("You are muted until ", until.Format(time.DateTime)),
+			fmt.Sprint("您已被禁言，解除时间：", until.Format(time.DateTime))))
+		return "", false
+	}
+
+	if s.chatRateLimited() {
+		return "", false
+	}
+
+	return filterStr(message), true
+}
+
+// chatRateLimited records a chat message (or a chat command) of the user, and tells if it exceeds the rate limit
+func (s *SessionData) chatRateLimited() bool {
+	ChatRateLimitMutex.Lock()
+	limitCount, limitWindow := ChatRateLimitCount, ChatRateLimitWindow
+	ChatRateLimitMutex.Unlock()
+
+	now := time.Now()
+	for len(s.chatTimes) > 0 && now.Sub(s.chatTimes[0]) >= limitWindow {
+		s.chatTimes = s.chatTimes[1:]
+	}
+	if limitCount > 0 && len(s.chatTimes) >= limitCount {
+		s.SendConsoleMessage(s.localized("Chat", "聊天"), s.localized(
+			"You are sending messages too fast",
+			"您发送消息的速度太快了"))
+		return true
+	}
+	s.chatTimes = append(s.chatTimes, now)
+	return false
+}
+
+func SetGlobalChat(enabled bool, slowMode time.Duration) {
+	list := list2.New()
+	globalChatMutex.Lock()
+	globalChatEnabled = enabled
+	globalChatSlowMode = slowMode
+	for _, S := range globalChatMembers {
+		list.PushBack(S)
+	}
+	globalChatMutex.Unlock()
+
+	// let the joined users know the new state of the channel
+	for it := list.Front(); it != nil; it = it.Next() {
+		it.Value.(*SessionData).SendGlobalChatStatus()
+	}
+}
+
+func (s *SessionData) SendGlobalChatStatus() bool {
+	globalChatMutex.Lock()
+	_, joined := globalChatMembers[s.steamId]
+	status := Isaacpb.ResponseGlobalChatStatus{
+		Joined:          joined,
+		Enabled:         globalChatEnabled,
+		SlowModeSeconds: uint32(globalChatSlowMode / time.Second),
+	}
+	globalChatMutex.Unlock()
+	return s.SendPackage(Isaacpb.ResponseHeader_GlobalChatStatus, 0, &status)
+}
+
+func (s *SessionData) JoinGlobalChat(join bool) {
+	globalChatMutex.Lock()
+	if join {
+		globalChatMembers[s.steamId] = s
+	} else {
+		delete(globalChatMembers, s.steamId)
+	}
+	globalChatMutex.Unlock()
+}
+
+func (s *SessionData) SayGlobal(message string) {
+	globalChatMutex.Lock()
+	_, joined := globalChatMembers[s.steamId]
+	enabled := globalChatEnabled
+	slowMode := globalChatSlowMode
+	globalChatMutex.Unlock()
+
+	if !joined {
+		return
+	}
+	if !enabled {
+		s.SendConsoleMessage(s.localized("Global chat", "全服聊天"), s.localized(
+			"Global chat is disabled by the admin",
+			"管理员已关闭全服聊天"))
+		return
+	}
+	if slowMode > 0 && time.Since(s.lastGlobalChat) < slowMode {
+		s.SendConsoleMessage(s.localized("Global chat", "全服聊天"), s.localized(
+			fmt.Sprint("Global chat is in slow mode, you can send one message every ", slowMode),
+			fmt.Sprint("全服聊天处于慢速模式，每", slowMode, "只能发送一条消息")))
+		return
+	}
+
+	filteredStr, ok := s.prepareChat(message)
+	if !ok {
+		return
+	}
+	s.lastGlobalChat = time.Now()
+
+	log.Print("user ", s.name, "(", s.steamId, ") say to global:", filteredStr, "(", message, ")")
+
+	list := list2.New()
+	globalChatMutex.Lock()
+	for _, S := range globalChatMembers {
+		list.PushBack(S)
+	}
+	globalChatMutex.Unlock()
+
+	pkg := Isaacpb.ResponseGlobalChat{
+		Steamid: int64(s.steamId),
+		Name:    s.name,
+		Message: filteredStr,
+	}
+	for it := list.Front(); it != nil; it = it.Next() {
+		if receiver := it.Value.(*SessionData); !IsBlocked(receiver.steamId, s.steamId) {
+			receiver.SendPackage(Isaacpb.ResponseHeader_GlobalChat, 0, &pkg)
+		}
+	}
+}
+
+func (s *SessionData) sendWhisperResult(target SteamID, message string, result Isaacpb.ResponseWhisper_WhisperResult) bool {
+	return s.SendPackage(Isaacpb.ResponseHeader_Whisper, 0, &Isaacpb.ResponseWhisper{
+		SteamidFrom: int64(s.steamId),
+		Name:        s.name,
+		SteamIdTo:   uint64(target),
+		Message:     message,
+		Result:      result,
+	})
+}
+
+// Whisper sends a private message to another online user, no matter where the two users are.
+func (s *SessionData) Whisper(target SteamID, message string) {
+	sessionsMutex.Lock()
+	other, ok := sessions[target]
+	sessionsMutex.Unlock()
+
+	if target == s.steamId {
+		s.SendConsoleMessage(s.localized("Whisper", "私聊"), s.localized(
+			"You can't whisper to yourself", "您不能私聊自己"))
+		s.sendWhisperResult(target, message, Isaacpb.ResponseWhisper_InvalidTarget)
+		return
+	}
+	if !ok {
+		s.SendConsoleMessage(s.localized("Whisper", "私聊"), s.localized(
+			fmt.Sprint("The player ", target, " is offline"),
+			fmt.Sprint("玩家", target, "不在线")))
+		s.sendWhisperResult(target, message, Isaacpb.ResponseWhisper_Offline)
+		return
+	}
+
+	if IsBlocked(target, s.steamId) || IsBlocked(s.steamId, target) {
+		s.SendConsoleMessage(s.localized("Whisper", "私聊"), s.localized(
+			"You can't whisper to the player", "您无法私聊该玩家"))
+		s.sendWhisperResult(target, message, Isaacpb.ResponseWhisper_Blocked)
+		return
+	}
+
+	filteredStr, ok := s.prepareChat(message)
+	if !ok {
+		s.sendWhisperResult(target, message, Isaacpb.ResponseWhisper_Rejected)
+		return
+	}
+
+	log.Print("user ", s.name, "(", s.steamId, ") whisper to ", other.name, "(", target, "):", filteredStr, "(", message, ")")
+
+	if !other.sendWhisperFrom(s, filteredStr) {
+		s.sendWhisperResult(target, filteredStr, Isaacpb.ResponseWhisper_Offline)
+		return
+	}
+	s.sendWhisperResult(target, filteredStr, Isaacpb.ResponseWhisper_Delivered)
+}
+
+func (s *SessionData) sendWhisperFrom(from *SessionData, message string) bool {
+	return s.SendPackage(Isaacpb.ResponseHeader_Whisper, 0, &Isaacpb.ResponseWhisper{
+		SteamidFrom: int64(from.steamId),
+		Name:        from.name,
+		SteamIdTo:   uint64(s.steamId),
+		Message:     message,
+		Result:      Isaacpb.ResponseWhisper_Delivered,
+	})
+}
@@ -0,0 +1,290 @@
+/*
+	IsaacPaperServer server program
+	Copyright (C) 2024  frto027
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU Affero General Public License as
+	published by the Free Software Foundation, either version 3 of the
+	License, or (at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU Affero General Public License for more details.
+
+	You should have received a copy of the GNU Affero General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package Isaac
+
+import (
+	"fmt"
+	"log"
+	"math/rand"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// ChatCommand is a command that users can type into the lobby chat, like "/help".
+// Use RegisterChatCommand to add more commands to the server.
+type ChatCommand interface {
+	// Name is the command name without the leading '/'
+	Name() string
+	// Help is a one line description that /help displays to the user
+	Help(s *SessionData) string
+	// Run executes the command, the returned text is only sent to the user s
+	Run(s *SessionData, args string) string
+}
+
+var chatCommands = map[string]ChatCommand{}
+var chatCommandsMutex = sync.Mutex{}
+
+// the arguments of these commands are secrets, they are not written into the log
+var sensitiveChatCommands = map[string]bool{"password": true}
+
+type UserReport struct {
+	Time     time.Time
+	Reporter SteamID
+	Target   SteamID
+	Lobby    LobbyID
+	Reason   string
+}
+
+// the server keeps the latest MaxUserReports reports for lsreport
+const MaxUserReports = 500
+
+var userReports []UserReport
+var userReportsMutex = sync.Mutex{}
+
+var MessageOfTheDay *string = nil
+var MessageOfTheDayMutex = sync.Mutex{}
+
+func RegisterChatCommand(cmd ChatCommand) {
+	chatCommandsMutex.Lock()
+	chatCommands[strings.ToLower(cmd.Name())] = cmd
+	chatCommandsMutex.Unlock()
+}
+
+func (s *SessionData) HandleChatCommand(text string) {
+	if s.chatRateLimited() {
+		return
+	}
+
+	cmd := strings.SplitN(strings.TrimPrefix(text, "/"), " ", 2)
+	args := ""
+	if len(cmd) == 2 {
+		args = strings.TrimSpace(cmd[1])
+	}
+
+	name := strings.ToLower(cmd[0])
+	chatCommandsMutex.Lock()
+	c, ok := chatCommands[name]
+	sensitive := sensitiveChatCommands[name]
+	chatCommandsMutex.Unlock()
+
+	reply := ""
+	if ok {
+		if sensitive {
+			log.Print("user ", s.name, "(", s.steamId, ") run command:/", name, " [redacted]")
+		} else {
+			log.Print("user ", s.name, "(", s.steamId, ") run command:", text)
+		}
+		reply = c.Run(s, args)
+	} else {
+		reply = s.localized(
+			fmt.Sprint("Unknown command /", cmd[0], ", type /help for the command list"),
+			fmt.Sprint("未知的命令/", cmd[0], "，输入/help查看命令列表"))
+	}
+	if len(reply) > 0 {
+		s.SendConsoleMessage(s.localized("Server", "服务器"), reply)
+	}
+}
+
+type simpleChatCommand struct {
+	name   string
+	helpEn string
+	helpZh string
+	run    func(s *SessionData, args string) string
+}
+
+func (c *simpleChatCommand) Name() string {
+	return c.name
+}
+func (c *simpleChatCommand) Help(s *SessionData) string {
+	return s.localized(c.helpEn, c.helpZh)
+}
+func (c *simpleChatCommand) Run(s *SessionData, args string) string {
+	return c.run(s, args)
+}
+
+func init() {
+	RegisterChatCommand(&simpleChatCommand{
+		name:   "help",
+		helpEn: "/help  list the commands",
+		helpZh: "/help  列出所有命令",
+		run:    chatCommandHelp,
+	})
+	RegisterChatCommand(&simpleChatCommand{
+		name:   "who",
+		helpEn: "/who  list the lobby members and their latency",
+		helpZh: "/who  列出房间成员及其延迟",
+		run:    chatCommandWho,
+	})
+	RegisterChatCommand(&simpleChatCommand{
+		name:   "roll",
+		helpEn: "/roll [max]  roll a number between 1 and max(default 100)",
+		helpZh: "/roll [最大值]  掷出1到最大值(默认100)之间的数字",
+		run:    chatCommandRoll,
+	})
+	RegisterChatCommand(&simpleChatCommand{
+		name:   "report",
+		helpEn: "/report <player> <reason>  report a player to the admin",
+		helpZh: "/report <玩家> <原因>  向管理员举报玩家",
+		run:    chatCommandReport,
+	})
+	RegisterChatCommand(&simpleChatCommand{
+		name:   "motd",
+		helpEn: "/motd  display the message of the day",
+		helpZh: "/motd  显示今日消息",
+		run:    chatCommandMotd,
+	})
+}
+
+func chatCommandHelp(s *SessionData, _ string) string {
+	chatCommandsMutex.Lock()
+	names := make([]string, 0, len(chatCommands))
+	for name := range chatCommands {
+		names = append(names, name)
+	}
+	cmds := make([]ChatCommand, len(names))
+	sort.Strings(names)
+	for i, name := range names {
+		cmds[i] = chatCommands[name]
+	}
+	chatCommandsMutex.Unlock()
+
+	str := ""
+	for _, c := range cmds {
+		str += c.Help(s) + "\n"
+	}
+	return str
+}
+
+func chatCommandWho(s *SessionData, _ string) string {
+	lobbiesMutex.Lock()
+	L, ok := lobbies[s.currentLobby]
+	lobbiesMutex.Unlock()
+	if !ok {
+		return s.localized("You are not in a lobby", "您不在房间中")
+	}
+
+	L.lobbyMutex.Lock()
+	users := make([]SteamID, 0, len(L.users))
+	for _, u := range L.users {
+		if u != 0 {
+			users = append(users, u)
+		}
+	}
+	owner := L.owner
+	spectators := append([]SteamID(nil), L.spectators...)
+	L.lobbyMutex.Unlock()
+	users = append(users, spectators...)
+
+	str := ""
+	for _, u := range users {
+		sessionsMutex.Lock()
+		other, ok := sessions[u]
+		sessionsMutex.Unlock()
+		if !ok {
+			continue
+		}
+		latency := "?"
+		if rtt, ok := other.Latency(); ok {
+			latency = fmt.Sprint(rtt.Milliseconds(), "ms")
+		}
+		str += fmt.Sprint(other.name, "(", u, ") ", latency)
+		if u == owner {
+			str += s.localized(" [owner]", " [房主]")
+		}
+		if _, spectating := other.LobbyState(); spectating {
+			str += s.localized(" [spectator]", " [旁观]")
+		}
+		str += "\n"
+	}
+	return str
+}
+
+func chatCommandRoll(s *SessionData, args string) string {
+	limit := 100
+	if len(args) > 0 {
+		n, err := strconv.Atoi(args)
+		if err != nil || n < 1 {
+			return s.localized("usage: /roll [max]", "用法：/roll [最大值]")
+		}
+		limit = n
+	}
+	n := rand.Intn(limit) + 1
+	return s.localized(
+		fmt.Sprint("You rolled ", n, " (1-", limit, ")"),
+		fmt.Sprint("您掷出了", n, "（1-", limit, "）"))
+}
+
+// findPlayer looks up an online player by steam ID or by name
+func findPlayer(name string) (*SessionData, bool) {
+	sessionsMutex.Lock()
+	defer sessionsMutex.Unlock()
+	if id, err := strconv.ParseUint(name, 10, 64); err == nil {
+		if other, ok := sessions[SteamID(id)]; ok {
+			return other, true
+		}
+	}
+	for _, other := range sessions {
+		if other.name == name {
+			return other, true
+		}
+	}
+	return nil, false
+}
+
+func chatCommandReport(s *SessionData, args string) string {
+	argss := strings.SplitN(args, " ", 2)
+	if len(argss) != 2 || len(argss[1]) == 0 {
+		return s.localized("usage: /report <player> <reason>", "用法：/report <玩家> <原因>")
+	}
+	other, ok := findPlayer(argss[0])
+	if !ok {
+		return s.localized(
+			fmt.Sprint("The player ", argss[0], " is not found"),
+			fmt.Sprint("找不到玩家", argss[0]))
+	}
+
+	report := UserReport{
+		Time:     time.Now(),
+		Reporter: s.steamId,
+		Target:   other.steamId,
+		Lobby:    s.currentLobby,
+		Reason:   argss[1],
+	}
+	userReportsMutex.Lock()
+	userReports = append(userReports, report)
+	if len(userReports) > MaxUserReports {
+		userReports = userReports[len(userReports)-MaxUserReports:]
+	}
+	userReportsMutex.Unlock()
+
+	log.Print("user ", s.name, "(", s.steamId, ") reports ", other.name, "(", other.steamId, "):", report.Reason)
+	return s.localized("Your report has been sent to the admin", "您的举报已提交给管理员")
+}
+
+func chatCommandMotd(s *SessionData, _ string) string {
+	MessageOfTheDayMutex.Lock()
+	defer MessageOfTheDayMutex.Unlock()
+	if MessageOfTheDay == nil {
+		return s.localized("There is no message of the day", "今日没有消息")
+	}
+	return *MessageOfTheDay
+}
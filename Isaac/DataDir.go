@@ -0,0 +1,77 @@
+/*
+	IsaacPaperServer server program
+	Copyright (C) 2024  frto027
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU Affero General Public License as
+	published by the Free Software Foundation, either version 3 of the
+	License, or (at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU Affero General Public License for more details.
+
+	You should have received a copy of the GNU Affero General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package Isaac
+
+import (
+	"os"
+	"path/filepath"
+	"sync"
+)
+
+var (
+	// the server state is saved into dataDir, nothing is saved if it is empty
+	dataDir      string
+	dataDirMutex = sync.Mutex{}
+)
+
+func SetDataDir(dir string) error {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return err
+	}
+	dataDirMutex.Lock()
+	dataDir = dir
+	dataDirMutex.Unlock()
+	return nil
+}
+
+// dataFile returns the path of the file in the data directory, or "" if there is no data directory
+func dataFile(name string) string {
+	dataDirMutex.Lock()
+	defer dataDirMutex.Unlock()
+	if dataDir == "" {
+		return ""
+	}
+	return filepath.Join(dataDir, name)
+}
+
+// readDataFile returns nil without error if the file doesn't exist
+func readDataFile(name string) ([]byte, error) {
+	path := dataFile(name)
+	if path == "" {
+		return nil, nil
+	}
+	content, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	return content, err
+}
+
+// writeDataFile writes a temporary file first, so the old file is kept if the server stops while writing
+func writeDataFile(name string, content []byte) error {
+	path := dataFile(name)
+	if path == "" {
+		return nil
+	}
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, content, 0644); err != nil {
+		return err
+	}
+	return os.Rename(tmp, path)
+}
@@ -0,0 +1,154 @@
+/*
+	IsaacPaperServer server program
+	Copyright (C) 2024  frto027
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU Affero General Public License as
+	published by the Free Software Foundation, either version 3 of the
+	License, or (at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU Affero General Public License for more details.
+
+	You should have received a copy of the GNU Affero General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package Isaac
+
+import (
+	"IsaacPaperServer/0xf7.top/IsaacPaperServer/Isaacpb"
+	"crypto/rand"
+	"log"
+	"strings"
+	"sync"
+)
+
+// 32 characters without the easily confused ones like O/0 and I/1
+const inviteCodeAlphabet = "ABCDEFGHJKLMNPQRSTUVWXYZ23456789"
+const InviteCodeLength = 6
+
+var (
+	// LobbyData.inviteCode is also guarded by inviteCodesMutex
+	inviteCodes      = map[string]LobbyID{}
+	inviteCodesMutex = sync.Mutex{}
+)
+
+func newInviteCodeNoLock() string {
+	bts := make([]byte, InviteCodeLength)
+	for {
+		if _, err := rand.Read(bts); err != nil {
+			log.Panic(err)
+		}
+		for i := range bts {
+			bts[i] = inviteCodeAlphabet[bts[i]%byte(len(inviteCodeAlphabet))]
+		}
+		if _, used := inviteCodes[string(bts)]; !used {
+			return string(bts)
+		}
+	}
+}
+
+// InviteCode returns the invite code of the lobby, a new one is created if the lobby has no code
+func (L *LobbyData) InviteCode(regenerate bool) string {
+	inviteCodesMutex.Lock()
+	defer inviteCodesMutex.Unlock()
+	if L.inviteCode != "" && !regenerate {
+		return L.inviteCode
+	}
+	delete(inviteCodes, L.inviteCode)
+	L.inviteCode = newInviteCodeNoLock()
+	inviteCodes[L.inviteCode] = L.id
+	return L.inviteCode
+}
+
+// RevokeInviteCode should also be called when the lobby is removed
+func (L *LobbyData) RevokeInviteCode() {
+	inviteCodesMutex.Lock()
+	delete(inviteCodes, L.inviteCode)
+	L.inviteCode = ""
+	inviteCodesMutex.Unlock()
+}
+
+func LobbyByInviteCode(code string) (*LobbyData, bool) {
+	code = strings.ToUpper(strings.TrimSpace(code))
+	inviteCodesMutex.Lock()
+	id, ok := inviteCodes[code]
+	inviteCodesMutex.Unlock()
+	if !ok {
+		return nil, false
+	}
+	lobbiesMutex.Lock()
+	defer lobbiesMutex.Unlock()
+	L, ok := lobbies[id]
+	return L, ok
+}
+
+// ManageInviteCode returns the current code of the lobby, or the reason why the user can't do it
+func (s *SessionData) ManageInviteCode(id LobbyID, action Isaacpb.RequestLobbyInviteCode_Action) (string, string) {
+	L, reason := s.ownedLobby(id)
+	if L == nil {
+		return "", reason
+	}
+	switch action {
+	case Isaacpb.RequestLobbyInviteCode_Revoke:
+		L.RevokeInviteCode()
+		log.Print("user ", s.name, "(", s.steamId, ") revokes the invite code of lobby ", L.id)
+		return "", ""
+	case Isaacpb.RequestLobbyInviteCode_Regenerate:
+		code := L.InviteCode(true)
+		log.Print("user ", s.name, "(", s.steamId, ") regenerates the invite code of lobby ", L.id)
+		return code, ""
+	default:
+		return L.InviteCode(false), ""
+	}
+}
+
+// JoinLobbyByCode joins the lobby without the password
+func (s *SessionData) JoinLobbyByCode(msg *Isaacpb.RequestJoinLobbyByCode, holdValue int32) error {
+	L, ok := LobbyByInviteCode(msg.Code)
+	if !ok {
+		log.Print("user ", s.name, "(", s.steamId, ") uses an invalid invite code ", msg.Code)
+		s.SendConsoleMessage(s.localized("Failed to join the lobby", "房间加入失败"), s.localized(
+			"The invite code is invalid or expired", "邀请码无效或已过期"))
+		s.SendPackage(Isaacpb.ResponseHeader_LobbyJoin, holdValue, &Isaacpb.ResponseLobbyJoin{
+			ChatRoomEnterResponse: uint32(Isaacpb.ResponseLobbyJoin_DoesntExist),
+			ChatPermissions:       1,
+		})
+		return nil
+	}
+	return s.handleJoinLobby(&Isaacpb.RequestJoinLobby{
+		LobbyID:  uint64(L.id),
+		Spectate: msg.Spectate,
+	}, holdValue, true)
+}
+
+func init() {
+	RegisterChatCommand(&simpleChatCommand{
+		name:   "invite",
+		helpEn: "/invite [new|off]  (owner) show, regenerate or revoke the invite code of the lobby",
+		helpZh: "/invite [new|off]  (房主)查看、重新生成或取消房间的邀请码",
+		run: func(s *SessionData, args string) string {
+			action := Isaacpb.RequestLobbyInviteCode_Get
+			switch args {
+			case "":
+			case "new":
+				action = Isaacpb.RequestLobbyInviteCode_Regenerate
+			case "off":
+				action = Isaacpb.RequestLobbyInviteCode_Revoke
+			default:
+				return s.localized("usage: /invite [new|off]", "用法：/invite [new|off]")
+			}
+			code, reason := s.ManageInviteCode(s.currentLobby, action)
+			if len(reason) > 0 {
+				return reason
+			}
+			if code == "" {
+				return s.localized("The invite code is revoked", "邀请码已取消")
+			}
+			return s.localized("Invite code: ", "邀请码：") + code
+		},
+	})
+}
@@ -0,0 +1,239 @@
+/*
+	IsaacPaperServer server program
+	Copyright (C) 2024  frto027
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU Affero General Public License as
+	published by the Free Software Foundation, either version 3 of the
+	License, or (at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU Affero General Public License for more details.
+
+	You should have received a copy of the GNU Affero General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package Isaac
+
+import (
+	"IsaacPaperServer/0xf7.top/IsaacPaperServer/Isaacpb"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+var (
+	JoinApprovalTimeout      = time.Minute
+	JoinApprovalTimeoutMutex = sync.Mutex{}
+
+	// a user waits for at most one lobby at a time
+	pendingJoins      = map[SteamID]*pendingJoin{}
+	pendingJoinsMutex = sync.Mutex{}
+)
+
+// pendingJoin is a join request waiting for the owner, the response is sent with the holdValue of the request
+type pendingJoin struct {
+	session   *SessionData
+	lobby     *LobbyData
+	msg       *Isaacpb.RequestJoinLobby
+	holdValue int32
+}
+
+// needsApproval tells if the owner must accept the user
+func (L *LobbyData) needsApproval(user SteamID) bool {
+	L.lobbyMutex.Lock()
+	defer L.lobbyMutex.Unlock()
+	_, reserved := L.reserved[user]
+	return L.requireApproval && L.owner != user && !reserved
+}
+
+func (p *pendingJoin) reject(en string, zh string) {
+	s := p.session
+	s.SendConsoleMessage(s.localized("Failed to join the lobby", "房间加入失败"), s.localized(en, zh))
+	s.SendPackage(Isaacpb.ResponseHeader_LobbyJoin, p.holdValue, &Isaacpb.ResponseLobbyJoin{
+		LobbyId:               uint32(p.lobby.id),
+		ChatRoomEnterResponse: uint32(Isaacpb.ResponseLobbyJoin_NotAllowed),
+		ChatPermissions:       1,
+	})
+}
+
+// notifyOwner tells the current owner about the request, or that the request is gone
+func (p *pendingJoin) notifyOwner(cancelled bool) {
+	p.lobby.lobbyMutex.Lock()
+	owner := p.lobby.owner
+	p.lobby.lobbyMutex.Unlock()
+
+	sessionsMutex.Lock()
+	session, ok := sessions[owner]
+	sessionsMutex.Unlock()
+	if !ok {
+		return
+	}
+	if !cancelled {
+		session.SendConsoleMessage(session.localized("Lobby", "房间"), session.localized(
+			fmt.Sprint(p.session.name, "(", p.session.steamId, ") wants to join, answer with /accept or /deny"),
+			fmt.Sprint(p.session.name, "(", p.session.steamId, ")请求加入房间，使用/accept或/deny回复")))
+	}
+	session.SendPackage(Isaacpb.ResponseHeader_LobbyJoinRequest, 0, &Isaacpb.ResponseLobbyJoinRequest{
+		LobbyId:   uint64(p.lobby.id),
+		SteamId:   uint64(p.session.steamId),
+		Name:      p.session.name,
+		Cancelled: cancelled,
+	})
+}
+
+// takePendingJoin removes the request of the user, only if it is still p when p is not nil
+func takePendingJoin(user SteamID, p *pendingJoin) *pendingJoin {
+	pendingJoinsMutex.Lock()
+	defer pendingJoinsMutex.Unlock()
+	current, ok := pendingJoins[user]
+	if !ok || (p != nil && current != p) {
+		return nil
+	}
+	delete(pendingJoins, user)
+	return current
+}
+
+// requestJoinApproval holds the join request until the owner answers it
+func (s *SessionData) requestJoinApproval(L *LobbyData, msg *Isaacpb.RequestJoinLobby, holdValue int32) {
+	JoinApprovalTimeoutMutex.Lock()
+	timeout := JoinApprovalTimeout
+	JoinApprovalTimeoutMutex.Unlock()
+
+	p := &pendingJoin{session: s, lobby: L, msg: msg, holdValue: holdValue}
+	if old := takePendingJoin(s.steamId, nil); old != nil {
+		old.notifyOwner(true)
+		old.reject("You have asked to join another lobby", "您已申请加入其他房间")
+	}
+	pendingJoinsMutex.Lock()
+	pendingJoins[s.steamId] = p
+	pendingJoinsMutex.Unlock()
+
+	log.Print("user ", s.name, "(", s.steamId, ") asks the owner of lobby ", L.id, " to join")
+	s.SendConsoleMessage(s.localized("Lobby", "房间"), s.localized(
+		"Waiting for the lobby owner to accept you", "正在等待房主同意您的加入请求"))
+	p.notifyOwner(false)
+
+	time.AfterFunc(timeout, func() {
+		if takePendingJoin(s.steamId, p) != nil {
+			log.Print("the join request of user ", s.name, "(", s.steamId, ") to lobby ", L.id, " timed out")
+			p.notifyOwner(true)
+			p.reject("The lobby owner didn't answer your request", "房主没有回应您的加入请求")
+		}
+	})
+}
+
+// cancelJoinApproval is called when the requester leaves the server
+func (s *SessionData) cancelJoinApproval() {
+	if p := takePendingJoin(s.steamId, nil); p != nil {
+		p.notifyOwner(true)
+	}
+}
+
+// AnswerJoinRequest lets the owner accept or deny a pending request
+func (s *SessionData) AnswerJoinRequest(id LobbyID, requester SteamID, accept bool) string {
+	L, reason := s.ownedLobby(id)
+	if L == nil {
+		return reason
+	}
+	pendingJoinsMutex.Lock()
+	p, ok := pendingJoins[requester]
+	pendingJoinsMutex.Unlock()
+	if !ok || p.lobby != L || takePendingJoin(requester, p) == nil {
+		return s.localized("The player is not asking to join the lobby", "该玩家没有申请加入房间")
+	}
+
+	log.Print("user ", s.name, "(", s.steamId, ") answers the join request of ", requester, " to lobby ", L.id, ": ", accept)
+	if !accept {
+		p.reject("The lobby owner denied your request", "房主拒绝了您的加入请求")
+		return ""
+	}
+	// the requester joins on its own lock, so it doesn't race its requests or its Close
+	other := p.session
+	other.RunTask(func() {
+		sessionsMutex.Lock()
+		registered := sessions[other.steamId] == other
+		sessionsMutex.Unlock()
+		if !registered {
+			return
+		}
+		if other.currentLobby != 0 {
+			p.reject("You are already in a lobby", "您已经在房间中了")
+			return
+		}
+		// the owner's consent is the same as an invite
+		_ = other.handleJoinLobby(p.msg, p.holdValue, true)
+	})
+	return ""
+}
+
+// pendingJoinTarget finds the requester of the lobby by name or SteamID for the chat commands
+func (s *SessionData) pendingJoinTarget(args string) SteamID {
+	args = strings.TrimSpace(args)
+	id, err := strconv.ParseUint(args, 10, 64)
+	pendingJoinsMutex.Lock()
+	defer pendingJoinsMutex.Unlock()
+	for user, p := range pendingJoins {
+		if p.lobby.id != s.currentLobby {
+			continue
+		}
+		if (err == nil && uint64(user) == id) || strings.EqualFold(p.session.name, args) {
+			return user
+		}
+	}
+	return 0
+}
+
+func (s *SessionData) SetLobbyApproval(id LobbyID, require bool) string {
+	L, reason := s.ownedLobby(id)
+	if L == nil {
+		return reason
+	}
+	L.lobbyMutex.Lock()
+	L.requireApproval = require
+	L.lobbyMutex.Unlock()
+	MarkLobbyDirty(L.id)
+	log.Print("user ", s.name, "(", s.steamId, ") sets lobby ", L.id, " require approval:", require)
+	return ""
+}
+
+func init() {
+	answer := func(accept bool) func(s *SessionData, args string) string {
+		return func(s *SessionData, args string) string {
+			target := s.pendingJoinTarget(args)
+			if target == 0 {
+				return s.localized("The player is not asking to join the lobby", "该玩家没有申请加入房间")
+			}
+			return ownerCommandReply(s, s.AnswerJoinRequest(s.currentLobby, target, accept))
+		}
+	}
+	RegisterChatCommand(&simpleChatCommand{
+		name:   "accept",
+		helpEn: "/accept <player>  (owner) let the player join the lobby",
+		helpZh: "/accept <玩家>  (房主)同意玩家加入房间",
+		run:    answer(true),
+	})
+	RegisterChatCommand(&simpleChatCommand{
+		name:   "deny",
+		helpEn: "/deny <player>  (owner) reject the join request of the player",
+		helpZh: "/deny <玩家>  (房主)拒绝玩家的加入请求",
+		run:    answer(false),
+	})
+	RegisterChatCommand(&simpleChatCommand{
+		name:   "approval",
+		helpEn: "/approval on|off  (owner) require your approval for joining the lobby",
+		helpZh: "/approval on|off  (房主)加入房间需要房主同意",
+		run: func(s *SessionData, args string) string {
+			if args != "on" && args != "off" {
+				return s.localized("usage: /approval on|off", "用法：/approval on|off")
+			}
+			return ownerCommandReply(s, s.SetLobbyApproval(s.currentLobby, args == "on"))
+		},
+	})
+}
@@ -0,0 +1,237 @@
+/*
+	IsaacPaperServer server program
+	Copyright (C) 2024  frto027
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU Affero General Public License as
+	published by the Free Software Foundation, either version 3 of the
+	License, or (at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU Affero General Public License for more details.
+
+	You should have received a copy of the GNU Affero General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package Isaac
+
+import (
+	"IsaacPaperServer/0xf7.top/IsaacPaperServer/Isaacpb"
+	"sync"
+	"time"
+)
+
+// JoinRequest is what the join checks look at
+type JoinRequest struct {
+	Session  *SessionData
+	LobbyID  LobbyID
+	Lobby    *LobbyData // nil if the lobby doesn't exist
+	Password *string
+	Spectate bool
+	Invited  bool // joined with the invite code, the password is not needed
+}
+
+// JoinCheck is one step of the lobby join admission. The checks run in the order they are
+// registered, the first one that doesn't return Success rejects the join.
+type JoinCheck interface {
+	Name() string
+	// Check returns the enter response and a localized explanation for the user if it fails
+	Check(r *JoinRequest) (Isaacpb.ResponseLobbyJoin_EChatRoomEnterResponse, string)
+}
+
+var (
+	joinChecks      []JoinCheck
+	joinChecksMutex = sync.Mutex{}
+
+	// reject the users whose game image is not the same as the lobby
+	JoinRequireSameGameCrc = true
+	JoinCheckMutex         = sync.Mutex{}
+)
+
+// RegisterJoinCheck adds a check after the existing ones
+func RegisterJoinCheck(check JoinCheck) {
+	joinChecksMutex.Lock()
+	joinChecks = append(joinChecks, check)
+	joinChecksMutex.Unlock()
+}
+
+func runJoinChecks(r *JoinRequest) (Isaacpb.ResponseLobbyJoin_EChatRoomEnterResponse, string, string) {
+	joinChecksMutex.Lock()
+	checks := joinChecks
+	joinChecksMutex.Unlock()
+	for _, check := range checks {
+		if result, reason := check.Check(r); result != Isaacpb.ResponseLobbyJoin_Success {
+			return result, reason, check.Name()
+		}
+	}
+	return Isaacpb.ResponseLobbyJoin_Success, "", ""
+}
+
+type simpleJoinCheck struct {
+	name  string
+	check func(r *JoinRequest) (Isaacpb.ResponseLobbyJoin_EChatRoomEnterResponse, string)
+}
+
+func (c *simpleJoinCheck) Name() string {
+	return c.name
+}
+func (c *simpleJoinCheck) Check(r *JoinRequest) (Isaacpb.ResponseLobbyJoin_EChatRoomEnterResponse, string) {
+	return c.check(r)
+}
+
+func init() {
+	RegisterJoinCheck(&simpleJoinCheck{"exists", func(r *JoinRequest) (Isaacpb.ResponseLobbyJoin_EChatRoomEnterResponse, string) {
+		if r.Lobby == nil {
+			return Isaacpb.ResponseLobbyJoin_DoesntExist, r.Session.localized(
+				"The lobby doesn't exist", "房间不存在")
+		}
+		return Isaacpb.ResponseLobbyJoin_Success, ""
+	}})
+	RegisterJoinCheck(&simpleJoinCheck{"bans", func(r *JoinRequest) (Isaacpb.ResponseLobbyJoin_EChatRoomEnterResponse, string) {
+		if r.Lobby.IsBanned(r.Session.steamId) {
+			return Isaacpb.ResponseLobbyJoin_Banned, r.Session.localized(
+				"You are banned from the lobby by the owner", "您已被房主封禁，无法加入此房间")
+		}
+		return Isaacpb.ResponseLobbyJoin_Success, ""
+	}})
+	RegisterJoinCheck(&simpleJoinCheck{"joinable", func(r *JoinRequest) (Isaacpb.ResponseLobbyJoin_EChatRoomEnterResponse, string) {
+		r.Lobby.lobbyMutex.Lock()
+		joinable := r.Lobby.joinable
+		r.Lobby.lobbyMutex.Unlock()
+		if !joinable {
+			return Isaacpb.ResponseLobbyJoin_NotAllowed, r.Session.localized(
+				"The lobby owner doesn't allow anyone to join", "房主禁止了其他人加入此房间")
+		}
+		return Isaacpb.ResponseLobbyJoin_Success, ""
+	}})
+	RegisterJoinCheck(&simpleJoinCheck{"compatibility", func(r *JoinRequest) (Isaacpb.ResponseLobbyJoin_EChatRoomEnterResponse, string) {
+		JoinCheckMutex.Lock()
+		checkCrc := JoinRequireSameGameCrc
+		JoinCheckMutex.Unlock()
+
+		r.Lobby.lobbyMutex.Lock()
+		protocolVer, gameCrc := r.Lobby.protocolVer, r.Lobby.gameCrc
+		r.Lobby.lobbyMutex.Unlock()
+		return r.Session.checkCompatibility(protocolVer, gameCrc, checkCrc)
+	}})
+	RegisterJoinCheck(&simpleJoinCheck{"spectators", func(r *JoinRequest) (Isaacpb.ResponseLobbyJoin_EChatRoomEnterResponse, string) {
+		if !r.Spectate {
+			return Isaacpb.ResponseLobbyJoin_Success, ""
+		}
+		MaxLobbySpectatorsMutex.Lock()
+		maxSpectators := MaxLobbySpectators
+		MaxLobbySpectatorsMutex.Unlock()
+
+		r.Lobby.lobbyMutex.Lock()
+		allow, count := r.Lobby.allowSpectators, len(r.Lobby.spectators)
+		r.Lobby.lobbyMutex.Unlock()
+		if !allow {
+			return Isaacpb.ResponseLobbyJoin_NotAllowed, r.Session.localized(
+				"The lobby owner doesn't allow spectators", "房主不允许旁观")
+		}
+		if count >= maxSpectators {
+			return Isaacpb.ResponseLobbyJoin_Full, r.Session.localized(
+				"There are too many spectators in the lobby", "房间中的旁观者太多了")
+		}
+		return Isaacpb.ResponseLobbyJoin_Success, ""
+	}})
+	RegisterJoinCheck(&simpleJoinCheck{"capacity", func(r *JoinRequest) (Isaacpb.ResponseLobbyJoin_EChatRoomEnterResponse, string) {
+		if r.Spectate {
+			return Isaacpb.ResponseLobbyJoin_Success, ""
+		}
+		L := r.Lobby
+		L.lobbyMutex.Lock()
+		free := L.freeSlotsNoLock(time.Now())
+		_, reserved := L.reserved[r.Session.steamId]
+		L.lobbyMutex.Unlock()
+		// the users matched by the matchmaker can use the slots reserved for them
+		if free <= 0 && !reserved {
+			return Isaacpb.ResponseLobbyJoin_Full, r.Session.localized(
+				"The lobby is full", "房间已满")
+		}
+		return Isaacpb.ResponseLobbyJoin_Success, ""
+	}})
+	RegisterJoinCheck(&simpleJoinCheck{"visibility", func(r *JoinRequest) (Isaacpb.ResponseLobbyJoin_EChatRoomEnterResponse, string) {
+		L := r.Lobby
+		L.lobbyMutex.Lock()
+		visibility, owner := L.visibility, L.owner
+		_, reserved := L.reserved[r.Session.steamId]
+		// the creator is already in the lobby when they send the join request
+		member := owner == r.Session.steamId || L.UserPosition(r.Session.steamId) != -1
+		L.lobbyMutex.Unlock()
+		if r.Invited || reserved || member {
+			return Isaacpb.ResponseLobbyJoin_Success, ""
+		}
+		switch visibility {
+		case Isaacpb.LobbyVisibility_Public:
+		case Isaacpb.LobbyVisibility_FriendsOnly:
+			if owner == 0 || !IsFriend(owner, r.Session.steamId) {
+				return Isaacpb.ResponseLobbyJoin_NotAllowed, r.Session.localized(
+					"Only the friends of the lobby owner can join", "只有房主的好友可以加入此房间")
+			}
+		case Isaacpb.LobbyVisibility_Private, Isaacpb.LobbyVisibility_Invisible:
+			return Isaacpb.ResponseLobbyJoin_NotAllowed, r.Session.localized(
+				"The lobby can only be joined with an invite code", "此房间只能通过邀请码加入")
+		default:
+			return Isaacpb.ResponseLobbyJoin_NotAllowed, r.Session.localized(
+				"The lobby can't be joined", "此房间无法加入")
+		}
+		return Isaacpb.ResponseLobbyJoin_Success, ""
+	}})
+	RegisterJoinCheck(&simpleJoinCheck{"blocks", func(r *JoinRequest) (Isaacpb.ResponseLobbyJoin_EChatRoomEnterResponse, string) {
+		r.Lobby.lobbyMutex.Lock()
+		members := append(append([]SteamID(nil), r.Lobby.users...), r.Lobby.spectators...)
+		r.Lobby.lobbyMutex.Unlock()
+		return r.Session.checkBlocks(members)
+	}})
+	// the password is checked last, the wrong ones are counted
+	RegisterJoinCheck(&simpleJoinCheck{"password", func(r *JoinRequest) (Isaacpb.ResponseLobbyJoin_EChatRoomEnterResponse, string) {
+		if r.Invited {
+			return Isaacpb.ResponseLobbyJoin_Success, ""
+		}
+		lockedReason, ok := r.Session.checkLobbyPassword(r.Lobby, r.Password)
+		if len(lockedReason) > 0 {
+			return Isaacpb.ResponseLobbyJoin_Limited, lockedReason
+		}
+		if !ok {
+			return Isaacpb.ResponseLobbyJoin_NotAllowed, r.Session.localized(
+				"Wrong password", "密码错误")
+		}
+		return Isaacpb.ResponseLobbyJoin_Success, ""
+	}})
+}
+
+// checkCompatibility tells if the session can play with a lobby of the protocol version and game crc,
+// the matchmaker uses it as well
+func (s *SessionData) checkCompatibility(protocolVer uint64, gameCrc uint32, checkCrc bool) (Isaacpb.ResponseLobbyJoin_EChatRoomEnterResponse, string) {
+	if protocolVer != 0 && protocolVer != s.protocolVer {
+		return Isaacpb.ResponseLobbyJoin_Limited, s.localized(
+			"The lobby is created by another version of PaperCup", "此房间由其他版本的纸杯创建")
+	}
+	if checkCrc && gameCrc != 0 && s.gameCrc != 0 && gameCrc != s.gameCrc {
+		return Isaacpb.ResponseLobbyJoin_Limited, s.localized(
+			"Your game version is different from the lobby", "您的游戏版本与房间不一致")
+	}
+	return Isaacpb.ResponseLobbyJoin_Success, ""
+}
+
+// checkBlocks tells if the session and the members have blocked each other, the matchmaker uses it as well
+func (s *SessionData) checkBlocks(members []SteamID) (Isaacpb.ResponseLobbyJoin_EChatRoomEnterResponse, string) {
+	for _, member := range members {
+		if member == 0 {
+			continue
+		}
+		if IsBlocked(member, s.steamId) {
+			return Isaacpb.ResponseLobbyJoin_MemberBlockedYou, s.localized(
+				"A member of the lobby has blocked you", "房间中有成员屏蔽了您")
+		}
+		if IsBlocked(s.steamId, member) {
+			return Isaacpb.ResponseLobbyJoin_YouBlockedMember, s.localized(
+				"You have blocked a member of the lobby", "您屏蔽了房间中的成员")
+		}
+	}
+	return Isaacpb.ResponseLobbyJoin_Success, ""
+}
@@ -0,0 +1,147 @@
+/*
+	IsaacPaperServer server program
+	Copyright (C) 2024  frto027
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU Affero General Public License as
+	published by the Free Software Foundation, either version 3 of the
+	License, or (at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU Affero General Public License for more details.
+
+	You should have received a copy of the GNU Affero General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package Isaac
+
+import (
+	"IsaacPaperServer/0xf7.top/IsaacPaperServer/Isaacpb"
+	"fmt"
+	"log"
+	"sync"
+	"time"
+)
+
+var (
+	MaxOwnedLobbies   = 2 // the lobbies a user owns at the same time
+	LobbyCreateLimit  = 5 // a user can create LobbyCreateLimit lobbies in LobbyCreateWindow
+	LobbyCreateWindow = time.Minute
+	MaxLobbies        = 1000 // the permanent lobbies are counted too
+	// the creator is put into the lobby, but the lobby is closed if nobody joins it with a join request
+	UnjoinedLobbyTTL      = time.Second * 30
+	LobbyCreateLimitMutex = sync.Mutex{}
+
+	lobbyCreateTimes      = map[SteamID][]time.Time{}
+	lobbyCreateTimesMutex = sync.Mutex{}
+)
+
+// lobbyCreateRejection checks the limits before a lobby is allocated, it returns the reason and a
+// localized message if the user can't create one now. An accepted call counts for the rate limit.
+func (s *SessionData) lobbyCreateRejection() (Isaacpb.ResponseRequestFailed_Reason, string) {
+	LobbyCreateLimitMutex.Lock()
+	maxOwned, limit, window, maxLobbies := MaxOwnedLobbies, LobbyCreateLimit, LobbyCreateWindow, MaxLobbies
+	LobbyCreateLimitMutex.Unlock()
+
+	owned := 0
+	lobbiesMutex.Lock()
+	count := len(lobbies)
+	for _, L := range lobbies {
+		L.lobbyMutex.Lock()
+		if L.owner == s.steamId {
+			owned++
+		}
+		L.lobbyMutex.Unlock()
+	}
+	lobbiesMutex.Unlock()
+
+	if count >= maxLobbies {
+		return Isaacpb.ResponseRequestFailed_ServerFull, s.localized(
+			"The server is full, no more lobbies can be created now", "服务器已满，暂时无法创建更多房间")
+	}
+	if owned >= maxOwned {
+		return Isaacpb.ResponseRequestFailed_QuotaExceeded, s.localized(
+			fmt.Sprint("You can own at most ", maxOwned, " lobbies at the same time"),
+			fmt.Sprint("您最多只能同时拥有", maxOwned, "个房间"))
+	}
+
+	now := time.Now()
+	lobbyCreateTimesMutex.Lock()
+	defer lobbyCreateTimesMutex.Unlock()
+	times := lobbyCreateTimes[s.steamId]
+	for len(times) > 0 && now.Sub(times[0]) > window {
+		times = times[1:]
+	}
+	if len(times) >= limit {
+		lobbyCreateTimes[s.steamId] = times
+		return Isaacpb.ResponseRequestFailed_RateLimited, s.localized(
+			fmt.Sprint("You are creating lobbies too fast, please wait ", times[0].Add(window).Sub(now).Round(time.Second)),
+			fmt.Sprint("您创建房间过于频繁，请等待", times[0].Add(window).Sub(now).Round(time.Second)))
+	}
+	lobbyCreateTimes[s.steamId] = append(times, now)
+	return Isaacpb.ResponseRequestFailed_Unknown, ""
+}
+
+// lobbyTableFull tells if no more lobbies can be opened, pending are the lobbies about to be added
+func lobbyTableFull(pending int) bool {
+	LobbyCreateLimitMutex.Lock()
+	maxLobbies := MaxLobbies
+	LobbyCreateLimitMutex.Unlock()
+	lobbiesMutex.Lock()
+	defer lobbiesMutex.Unlock()
+	return len(lobbies)+pending >= maxLobbies
+}
+
+// pruneLobbyCreateTimes forgets the users who haven't created lobbies in the window
+func pruneLobbyCreateTimes() {
+	LobbyCreateLimitMutex.Lock()
+	window := LobbyCreateWindow
+	LobbyCreateLimitMutex.Unlock()
+
+	now := time.Now()
+	lobbyCreateTimesMutex.Lock()
+	for user, times := range lobbyCreateTimes {
+		if len(times) == 0 || now.Sub(times[len(times)-1]) > window {
+			delete(lobbyCreateTimes, user)
+		}
+	}
+	lobbyCreateTimesMutex.Unlock()
+}
+
+// reapUnjoinedLobbies closes the lobbies whose creator never entered them
+func reapUnjoinedLobbies() int {
+	LobbyCreateLimitMutex.Lock()
+	ttl := UnjoinedLobbyTTL
+	LobbyCreateLimitMutex.Unlock()
+
+	var abandoned []*LobbyData
+	now := time.Now()
+	lobbiesMutex.Lock()
+	for _, L := range lobbies {
+		L.lobbyMutex.Lock()
+		if !L.joined && L.owner != 0 && now.Sub(L.createTime) > ttl {
+			abandoned = append(abandoned, L)
+		}
+		L.lobbyMutex.Unlock()
+	}
+	lobbiesMutex.Unlock()
+
+	count := 0
+	for _, L := range abandoned {
+		if L.isPermanent() {
+			continue
+		}
+		L.closeLobby("The lobby is closed because nobody entered it", "没有人进入房间，房间已被关闭")
+		log.Print("lobby ", L.name, "(", L.id, ") is closed, nobody joined it after it was created")
+		count++
+	}
+	if count > 0 {
+		lobbyLifecycleStatsMutex.Lock()
+		lobbyLifecycleStats.UnjoinedClosed += uint64(count)
+		lobbyLifecycleStatsMutex.Unlock()
+	}
+	return count
+}
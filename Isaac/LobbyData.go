@@ -23,7 +23,6 @@ import (
 	"errors"
 	"net/netip"
 	"sync"
-	"sync/atomic"
 	"time"
 )
 
@@ -33,32 +32,87 @@ func (s *LobbyID) SetID(id uint32) {
 	*s = LobbyID(id) // Currently we don't support any lobby property
 }
 
+var (
+	// DefaultLobbyMembers is used when the client doesn't ask for a lobby size,
+	// the game itself supports 4 players.
+	DefaultLobbyMembers = 4
+	MaxLobbyMembers     = 16
+	LobbyMembersMutex   = sync.Mutex{}
+)
+
 type LobbyData struct {
-	users      [4]SteamID
+	lastActivity int64 // unix nano, accessed atomically, the first field keeps it aligned on 32-bit platforms
+
+	users      []SteamID
 	owner      SteamID
 	id         LobbyID
 	data       map[string]string // Okay, no need to move it away from server...
-	memberData [4]map[string]string
+	memberData []map[string]string
 	lobbyMutex sync.Mutex
 
-	udpAddresses [4]netip.AddrPort
+	udpAddresses []netip.AddrPort
+
+	spectators         []SteamID
+	spectatorAddresses []netip.AddrPort
+	allowSpectators    bool
 
 	name      string
-	password  *string
+	password  *lobbyPassword
 	enableP2P bool
+	joinable  bool
+	group     int32
+	lang      Isaacpb.RequestLogin_Lang
+
+	visibility      Isaacpb.LobbyVisibility
+	requireApproval bool
+
+	// the versions of the creator, 0 if unknown
+	protocolVer uint64
+	gameCrc     uint32
 
-	createTime time.Time
+	bans      map[SteamID]bool // banned by the owner for the lifetime of the lobby
+	joinTimes map[SteamID]time.Time
+	reserved  map[SteamID]time.Time // the slots promised by the matchmaker, until the time
+
+	inviteCode string
+
+	joinFailures joinFailures // the wrong passwords tried by everyone, it locks the lobby for the strangers
+
+	createTime  time.Time
+	joined      bool // someone entered the lobby with a join request, the creator may never do it
+	closeWarned bool // the members are told that the lobby is closing
+	relayed     bool // the p2p packages of the lobby are relayed by the server, the idle timeout applies
+
+	permanent *PermanentLobby // defined by the admin, the lobby is reset instead of deleted when it is empty
 }
 
-var nextLobbyID = uint32(1)
+// LobbyCapacity returns the lobby size for the requested one, limited by the server
+func LobbyCapacity(requested int) int {
+	LobbyMembersMutex.Lock()
+	defer LobbyMembersMutex.Unlock()
+	if requested <= 0 {
+		requested = DefaultLobbyMembers
+	}
+	return max(1, min(requested, MaxLobbyMembers))
+}
 
-func (L *LobbyData) Create() {
-	L.id.SetID(atomic.AddUint32(&nextLobbyID, 1))
+func (L *LobbyData) Create(capacity int) {
+	L.id = newLobbyID()
+	L.users = make([]SteamID, capacity)
 	L.data = make(map[string]string)
-	L.memberData = [4]map[string]string{{}, {}, {}, {}}
+	L.memberData = make([]map[string]string, capacity)
+	for i := range L.memberData {
+		L.memberData[i] = map[string]string{}
+	}
 	L.lobbyMutex = sync.Mutex{}
-	L.udpAddresses = [4]netip.AddrPort{{}, {}, {}, {}}
+	L.udpAddresses = make([]netip.AddrPort, capacity)
 	L.createTime = time.Now()
+	L.touch()
+	L.joinable = true
+	L.allowSpectators = true
+	L.bans = make(map[SteamID]bool)
+	L.joinTimes = make(map[SteamID]time.Time)
+	L.reserved = make(map[SteamID]time.Time)
 }
 func (L *LobbyData) HasUser(user SteamID) bool {
 	for _, u := range L.users {
@@ -93,26 +147,38 @@ func (L *LobbyData) AddUser(user SteamID) (int, error) {
 	for i, u := range L.users {
 		if u == 0 {
 			L.users[i] = user
+			L.joinTimes[user] = time.Now()
 			return i, nil
 		}
 	}
 	return -1, errors.New("lobby is full")
 }
-func (L *LobbyData) RemoveUser(user SteamID) {
+
+// RemoveUser returns true if the user was the owner. In that case, the member who has
+// been in the lobby for the longest time becomes the new owner.
+func (L *LobbyData) RemoveUser(user SteamID) bool {
 	idx := L.UserPosition(user)
 	if idx != -1 {
 		L.users[idx] = 0
 		L.udpAddresses[idx] = netip.AddrPort{}
 	}
-	if L.owner == user {
-		L.owner = 0
-		// try to find a new owner
-		for _, u := range L.users {
-			if u != 0 {
-				L.owner = u
-			}
+	delete(L.joinTimes, user)
+	if L.owner != user {
+		return false
+	}
+	L.owner = 0
+	// try to find a new owner
+	since := time.Time{}
+	for _, u := range L.users {
+		if u == 0 {
+			continue
+		}
+		if L.owner == 0 || L.joinTimes[u].Before(since) {
+			L.owner = u
+			since = L.joinTimes[u]
 		}
 	}
+	return true
 }
 
 func (L *LobbyData) IsEmpty() bool {
@@ -129,10 +195,23 @@ func (L *LobbyData) ToProtobufLobbyInfo() *Isaacpb.LobbyInfo {
 	info := Isaacpb.LobbyInfo{}
 	info.LobbyId = uint64(L.id)
 	info.OwnerId = uint64(L.owner)
-	info.UserIds = []uint64{uint64(L.users[0]), uint64(L.users[1]), uint64(L.users[2]), uint64(L.users[3])}
+	info.UserIds = make([]uint64, len(L.users))
+	for i, u := range L.users {
+		info.UserIds[i] = uint64(u)
+	}
+	info.MaxMembers = int32(len(L.users))
+	info.SpectatorIds = make([]uint64, len(L.spectators))
+	for i, u := range L.spectators {
+		info.SpectatorIds[i] = uint64(u)
+	}
+	info.SpectatorsForbidden = !L.allowSpectators
 	info.Datas = make([]*Isaacpb.LobbyDataUpdateItem, len(L.data))
 	info.Name = L.name
 	info.HasPassword = L.password != nil
+	info.NotJoinable = !L.joinable
+	info.LobbyGroup = L.group
+	info.Visibility = L.visibility
+	info.RequireApproval = L.requireApproval
 	idx := 0
 	for k, v := range L.data {
 		info.Datas[idx] = &Isaacpb.LobbyDataUpdateItem{K: k, V: v}
@@ -144,8 +223,8 @@ func (L *LobbyData) ToProtobufLobbyInfo() *Isaacpb.LobbyInfo {
 func (L *LobbyData) ToProtobufLobbyInfoWithUserData() *Isaacpb.LobbyInfo {
 	info := L.ToProtobufLobbyInfo()
 	L.lobbyMutex.Lock()
-	info.UsersDatas = make([]*Isaacpb.SingleUserData, 4)
-	for i := 0; i < 4; i++ {
+	info.UsersDatas = make([]*Isaacpb.SingleUserData, len(L.users))
+	for i := range L.users {
 		singleData := Isaacpb.SingleUserData{}
 		info.UsersDatas[i] = &singleData
 		if L.users[i] == 0 {
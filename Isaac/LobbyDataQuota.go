@@ -0,0 +1,143 @@
+/*
+	IsaacPaperServer server program
+	Copyright (C) 2024  frto027
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU Affero General Public License as
+	published by the Free Software Foundation, either version 3 of the
+	License, or (at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU Affero General Public License for more details.
+
+	You should have received a copy of the GNU Affero General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package Isaac
+
+import (
+	"IsaacPaperServer/0xf7.top/IsaacPaperServer/Isaacpb"
+	"fmt"
+	"log"
+	"strings"
+	"sync"
+)
+
+var (
+	// the lobby data is sent with every lobby list, keep it small
+	MaxLobbyDataKeys   = 64
+	MaxMemberDataKeys  = 32
+	MaxDataKeyLength   = 64
+	MaxDataValueLength = 1024
+
+	// only the lobby owner (and the server itself) can write the keys with these prefixes
+	ReservedDataKeyPrefixes = []string{"server:", "owner:"}
+
+	DataQuotaMutex = sync.Mutex{}
+)
+
+type dataWriteError struct {
+	reason Isaacpb.ResponseRequestFailed_Reason
+	en     string
+	zh     string
+}
+
+func isReservedDataKey(key string) bool {
+	DataQuotaMutex.Lock()
+	defer DataQuotaMutex.Unlock()
+	for _, prefix := range ReservedDataKeyPrefixes {
+		if strings.HasPrefix(key, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+func quotaExceededError(maxKeys int) *dataWriteError {
+	return &dataWriteError{Isaacpb.ResponseRequestFailed_QuotaExceeded,
+		fmt.Sprint("There can be at most ", maxKeys, " keys"),
+		fmt.Sprint("最多只能有", maxKeys, "个键")}
+}
+
+func forbiddenKeyError(key string) *dataWriteError {
+	return &dataWriteError{Isaacpb.ResponseRequestFailed_Forbidden,
+		"Only the lobby owner can write the key " + key,
+		"只有房主可以修改" + key}
+}
+
+// checkDataWrite returns nil if the user can write the key into data, which holds at most maxKeys keys
+func checkDataWrite(data map[string]string, key string, value string, maxKeys int, isOwner bool) *dataWriteError {
+	if e := checkDataKeyValue(key, value, isOwner); e != nil {
+		return e
+	}
+	if _, exists := data[key]; !exists && len(data) >= maxKeys {
+		return quotaExceededError(maxKeys)
+	}
+	return nil
+}
+
+func checkDataKeyValue(key string, value string, isOwner bool) *dataWriteError {
+	DataQuotaMutex.Lock()
+	maxKeyLength, maxValueLength := MaxDataKeyLength, MaxDataValueLength
+	DataQuotaMutex.Unlock()
+
+	if len(key) == 0 || len(key) > maxKeyLength {
+		return &dataWriteError{Isaacpb.ResponseRequestFailed_InvalidData,
+			fmt.Sprint("The key must have 1 to ", maxKeyLength, " bytes"),
+			fmt.Sprint("键的长度必须在1到", maxKeyLength, "字节之间")}
+	}
+	if len(value) > maxValueLength {
+		return &dataWriteError{Isaacpb.ResponseRequestFailed_InvalidData,
+			fmt.Sprint("The value can't be longer than ", maxValueLength, " bytes"),
+			fmt.Sprint("值的长度不能超过", maxValueLength, "字节")}
+	}
+	if !isOwner && isReservedDataKey(key) {
+		return forbiddenKeyError(key)
+	}
+	return nil
+}
+
+// checkDataUpdate is checkDataWrite for a batch, the keys are deleted before the values are set
+func checkDataUpdate(data map[string]string, sets []*Isaacpb.LobbyDataUpdateItem, deletes []string, maxKeys int, isOwner bool) *dataWriteError {
+	count := len(data)
+	removed := map[string]bool{}
+	for _, key := range deletes {
+		if !isOwner && isReservedDataKey(key) {
+			return forbiddenKeyError(key)
+		}
+		if _, exists := data[key]; exists && !removed[key] {
+			removed[key] = true
+			count--
+		}
+	}
+	added := map[string]bool{}
+	for _, kv := range sets {
+		if e := checkDataKeyValue(kv.K, kv.V, isOwner); e != nil {
+			return e
+		}
+		if _, exists := data[kv.K]; (!exists || removed[kv.K]) && !added[kv.K] {
+			added[kv.K] = true
+			count++
+		}
+	}
+	// a batch that doesn't add keys is fine even if the limit was lowered
+	if count > maxKeys && count > len(data) {
+		return quotaExceededError(maxKeys)
+	}
+	return nil
+}
+
+func lobbyDataKeyLimits() (int, int) {
+	DataQuotaMutex.Lock()
+	defer DataQuotaMutex.Unlock()
+	return MaxLobbyDataKeys, MaxMemberDataKeys
+}
+
+// sendDataWriteFailed answers the rejected write, the session is kept
+func (s *SessionData) sendDataWriteFailed(messageType Isaacpb.ResponseHeader_ResponseMessageType, holdValue int32, key string, e *dataWriteError) bool {
+	log.Print("user ", s.name, "(", s.steamId, ") can't write [", key, "]: ", e.en)
+	return s.sendRequestFailed(messageType, holdValue, e.reason, s.localized(e.en, e.zh))
+}
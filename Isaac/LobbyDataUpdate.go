@@ -0,0 +1,113 @@
+/*
+	IsaacPaperServer server program
+	Copyright (C) 2024  frto027
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU Affero General Public License as
+	published by the Free Software Foundation, either version 3 of the
+	License, or (at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU Affero General Public License for more details.
+
+	You should have received a copy of the GNU Affero General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package Isaac
+
+import (
+	"IsaacPaperServer/0xf7.top/IsaacPaperServer/Isaacpb"
+	"errors"
+	"fmt"
+	"log"
+)
+
+// UpdateLobbyData changes the lobby data or the member data of the user at once, then the changes
+// are sent to the members with a single update
+func (s *SessionData) UpdateLobbyData(msg *Isaacpb.RequestUpdateLobbyData, holdValue int32) error {
+	lobbiesMutex.Lock()
+	L, ok := lobbies[LobbyID(msg.LobbyId)]
+	lobbiesMutex.Unlock()
+	if !ok {
+		return errors.New(fmt.Sprint("UpdateLobbyData: lobby ID not found:", msg.LobbyId))
+	}
+
+	maxLobbyKeys, maxMemberKeys := lobbyDataKeyLimits()
+	resp := Isaacpb.ResponseUpdateLobbyData{Success: true}
+	var writeErr *dataWriteError
+
+	L.lobbyMutex.Lock()
+	pos := L.UserPosition(s.steamId)
+	if pos == -1 {
+		L.lobbyMutex.Unlock()
+		return errors.New("UpdateLobbyData: user is not in the lobby")
+	}
+	data, maxKeys := L.data, maxLobbyKeys
+	if msg.MemberData {
+		data, maxKeys = L.memberData[pos], maxMemberKeys
+	}
+	for _, e := range msg.Expect {
+		v, exists := data[e.K]
+		if exists == e.Absent || (exists && v != e.V) {
+			resp.Success = false
+		}
+		if exists {
+			resp.Current = append(resp.Current, &Isaacpb.LobbyDataUpdateItem{K: e.K, V: v})
+		}
+	}
+	if resp.Success {
+		writeErr = checkDataUpdate(data, msg.Set, msg.Delete, maxKeys, L.owner == s.steamId)
+	}
+	if resp.Success && writeErr == nil {
+		for _, key := range msg.Delete {
+			delete(data, key)
+		}
+		for _, kv := range msg.Set {
+			data[kv.K] = kv.V
+		}
+	}
+	L.lobbyMutex.Unlock()
+
+	if writeErr != nil {
+		if !s.sendDataWriteFailed(Isaacpb.ResponseHeader_UpdateLobbyData, holdValue, "", writeErr) {
+			return errors.New("failed to send request failed package")
+		}
+		return nil
+	}
+	if !resp.Success {
+		log.Print("user ", s.name, "(", s.steamId, ") fails to update lobby ", L.id, " data, the expectation is not met")
+		if !s.SendPackage(Isaacpb.ResponseHeader_UpdateLobbyData, holdValue, &resp) {
+			return errors.New("failed to send update lobby data package")
+		}
+		return nil
+	}
+
+	L.touch()
+	log.Print("user ", s.name, "(", s.steamId, ") updates lobby ", L.id, " member data:", msg.MemberData,
+		", set ", len(msg.Set), " keys, delete ", len(msg.Delete), " keys")
+	resp.Current = nil
+	if !s.SendPackage(Isaacpb.ResponseHeader_UpdateLobbyData, holdValue, &resp) {
+		return errors.New("failed to send update lobby data package")
+	}
+	if len(msg.Set)+len(msg.Delete) == 0 {
+		return nil
+	}
+
+	update := Isaacpb.ResponseLobbyDataUpdate{
+		SteamIdLobby:  uint64(L.id),
+		SteamIdMember: uint64(s.steamId),
+		OnlyLobbyId:   !msg.MemberData,
+		Datas:         msg.Set,
+		DeletedKeys:   msg.Delete,
+	}
+	if msg.MemberData {
+		L.SendPackageToAllUsers(Isaacpb.ResponseHeader_LobbyMemberDataUpdate, 0, &update, s.steamId)
+	} else {
+		MarkLobbyDirty(L.id)
+		L.SendPackageToAllUsers(Isaacpb.ResponseHeader_LobbyDataUpdate, 0, &update, 0)
+	}
+	return nil
+}
@@ -0,0 +1,141 @@
+/*
+	IsaacPaperServer server program
+	Copyright (C) 2024  frto027
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU Affero General Public License as
+	published by the Free Software Foundation, either version 3 of the
+	License, or (at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU Affero General Public License for more details.
+
+	You should have received a copy of the GNU Affero General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package Isaac
+
+import (
+	"crypto/rand"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"sync"
+	"time"
+)
+
+// The lobby IDs are random 32-bit numbers, because ResponseLobbyJoin only has 32 bits for them.
+// The issued IDs are remembered for LobbyIDReuseWindow, and saved in the data directory so the
+// clients holding an old ID can't enter a new lobby after a restart. The list is written by the
+// lifecycle manager, not for every new lobby.
+
+const lobbyIDsFile = "lobby_ids.json"
+
+var (
+	LobbyIDReuseWindow = time.Hour * 24
+	LobbyIDMutex       = sync.Mutex{}
+
+	issuedLobbyIDs      = map[LobbyID]time.Time{}
+	issuedLobbyIDsMutex = sync.Mutex{}
+	lastLobbyIDPrune    time.Time
+	lobbyIDsDirty       bool // issuedLobbyIDs is changed since it was saved
+	// the saves are written one by one, so an older list never replaces a newer one
+	lobbyIDsSaveMutex = sync.Mutex{}
+)
+
+// LoadLobbyIDs reads the IDs issued before the restart from the data directory
+func LoadLobbyIDs() error {
+	content, err := readDataFile(lobbyIDsFile)
+	if err != nil || content == nil {
+		return err
+	}
+	loaded := map[LobbyID]time.Time{}
+	if err := json.Unmarshal(content, &loaded); err != nil {
+		return errors.New(fmt.Sprint("failed to parse ", lobbyIDsFile, ": ", err))
+	}
+	issuedLobbyIDsMutex.Lock()
+	for id, t := range loaded {
+		issuedLobbyIDs[id] = t
+	}
+	issuedLobbyIDsMutex.Unlock()
+	log.Print("load ", len(loaded), " issued lobby IDs from ", dataFile(lobbyIDsFile))
+	return nil
+}
+
+// saveLobbyIDs writes the issued IDs if they are changed, it is called by the lifecycle manager
+func saveLobbyIDs() {
+	lobbyIDsSaveMutex.Lock()
+	defer lobbyIDsSaveMutex.Unlock()
+	if dataFile(lobbyIDsFile) == "" {
+		return
+	}
+
+	issuedLobbyIDsMutex.Lock()
+	if !lobbyIDsDirty {
+		issuedLobbyIDsMutex.Unlock()
+		return
+	}
+	lobbyIDsDirty = false
+	content, err := json.Marshal(issuedLobbyIDs)
+	issuedLobbyIDsMutex.Unlock()
+	if err == nil {
+		err = writeDataFile(lobbyIDsFile, content)
+	}
+	if err != nil {
+		log.Print("failed to save the lobby IDs: ", err)
+	}
+}
+
+// pruneLobbyIDsNoLock forgets the IDs issued before the window, unless the lobby is still open
+func pruneLobbyIDsNoLock(now time.Time, window time.Duration) {
+	if now.Sub(lastLobbyIDPrune) < time.Minute {
+		return
+	}
+	lastLobbyIDPrune = now
+	lobbiesMutex.Lock()
+	for id, t := range issuedLobbyIDs {
+		if _, open := lobbies[id]; !open && now.Sub(t) > window {
+			delete(issuedLobbyIDs, id)
+			lobbyIDsDirty = true
+		}
+	}
+	lobbiesMutex.Unlock()
+}
+
+// IssuedLobbyIDCount returns how many IDs can't be used now
+func IssuedLobbyIDCount() int {
+	issuedLobbyIDsMutex.Lock()
+	defer issuedLobbyIDsMutex.Unlock()
+	return len(issuedLobbyIDs)
+}
+
+// newLobbyID returns an ID which is not used by any lobby in the window
+func newLobbyID() LobbyID {
+	LobbyIDMutex.Lock()
+	window := LobbyIDReuseWindow
+	LobbyIDMutex.Unlock()
+
+	now := time.Now()
+	var bts [4]byte
+	issuedLobbyIDsMutex.Lock()
+	pruneLobbyIDsNoLock(now, window)
+	id := LobbyID(0)
+	for {
+		if _, err := rand.Read(bts[:]); err != nil {
+			log.Panic(err)
+		}
+		id.SetID(binary.LittleEndian.Uint32(bts[:]))
+		if _, issued := issuedLobbyIDs[id]; id != 0 && !issued {
+			break
+		}
+	}
+	issuedLobbyIDs[id] = now
+	lobbyIDsDirty = true
+	issuedLobbyIDsMutex.Unlock()
+	return id
+}
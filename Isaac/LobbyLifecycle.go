@@ -0,0 +1,267 @@
+/*
+	IsaacPaperServer server program
+	Copyright (C) 2024  frto027
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU Affero General Public License as
+	published by the Free Software Foundation, either version 3 of the
+	License, or (at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU Affero General Public License for more details.
+
+	You should have received a copy of the GNU Affero General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package Isaac
+
+import (
+	"IsaacPaperServer/0xf7.top/IsaacPaperServer/Isaacpb"
+	list2 "container/list"
+	"fmt"
+	"log"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// The lifecycle manager closes the lobbies nobody uses. The permanent lobbies are never closed.
+
+var (
+	LobbyLifecycleInterval = time.Second * 10
+	EmptyLobbyTTL          = time.Minute // an empty lobby is deleted after it is inactive for so long
+	MaxLobbyAge            = time.Duration(0)
+	// no chat, data or relay traffic, 0 means no limit. The p2p lobbies whose game traffic goes directly
+	// between the peers are never idle, the server can't see it. Once the tcp relay is used, they can be.
+	LobbyIdleTimeout    = time.Duration(0)
+	LobbyCloseWarning   = time.Minute
+	LobbyLifecycleMutex = sync.Mutex{}
+
+	lobbyLifecycleStats      = LobbyLifecycleStats{}
+	lobbyLifecycleStatsMutex = sync.Mutex{}
+)
+
+type LobbyLifecycleStats struct {
+	EmptyDeleted   uint64
+	UnjoinedClosed uint64
+	AgeClosed      uint64
+	IdleClosed     uint64
+	Warned         uint64
+	LastRun        time.Time
+}
+
+func GetLobbyLifecycleStats() LobbyLifecycleStats {
+	lobbyLifecycleStatsMutex.Lock()
+	defer lobbyLifecycleStatsMutex.Unlock()
+	return lobbyLifecycleStats
+}
+
+// touch records the activity of the lobby, it is safe to call with or without the lobby locks
+func (L *LobbyData) touch() {
+	atomic.StoreInt64(&L.lastActivity, time.Now().UnixNano())
+}
+
+func (L *LobbyData) LastActivity() time.Time {
+	return time.Unix(0, atomic.LoadInt64(&L.lastActivity))
+}
+
+// DeleteOldLobbies deletes the empty lobbies that are inactive for EmptyLobbyTTL
+func DeleteOldLobbies() int {
+	LobbyLifecycleMutex.Lock()
+	deleteIfBefore := time.Now().Add(-EmptyLobbyTTL)
+	LobbyLifecycleMutex.Unlock()
+
+	toDel := list2.New()
+	lobbiesMutex.Lock()
+	for _, L := range lobbies {
+		L.lobbyMutex.Lock()
+		empty := L.UserCount() == 0
+		L.lobbyMutex.Unlock()
+		if empty && L.LastActivity().Before(deleteIfBefore) {
+			toDel.PushBack(L)
+		}
+	}
+	lobbiesMutex.Unlock()
+
+	// closeLobby releases the spectators left in the lobby
+	count := 0
+	for e := toDel.Front(); e != nil; e = e.Next() {
+		L := e.Value.(*LobbyData)
+		if L.isPermanent() {
+			continue
+		}
+		L.closeLobby("The lobby is closed because it is empty", "房间已空，已被关闭")
+		count++
+	}
+
+	if count > 0 {
+		lobbyLifecycleStatsMutex.Lock()
+		lobbyLifecycleStats.EmptyDeleted += uint64(count)
+		lobbyLifecycleStatsMutex.Unlock()
+		log.Print("delete ", count, " empty lobbies")
+	}
+	return count
+}
+
+// closeDeadline returns when the lobby should be closed and why, zero if it never expires
+func (L *LobbyData) closeDeadline(maxAge time.Duration, idleTimeout time.Duration) (time.Time, bool) {
+	deadline, idle := time.Time{}, false
+	if maxAge > 0 {
+		deadline = L.createTime.Add(maxAge)
+	}
+	L.lobbyMutex.Lock()
+	direct := L.enableP2P && !L.relayed
+	L.lobbyMutex.Unlock()
+	if idleTimeout > 0 && !direct {
+		if t := L.LastActivity().Add(idleTimeout); deadline.IsZero() || t.Before(deadline) {
+			deadline, idle = t, true
+		}
+	}
+	return deadline, idle
+}
+
+// closeLobby removes the lobby and sends its members back to the lobby browser
+func (L *LobbyData) closeLobby(en string, zh string) int {
+	lobbiesMutex.Lock()
+	_, ok := lobbies[L.id]
+	delete(lobbies, L.id)
+	lobbiesMutex.Unlock()
+	if !ok {
+		return 0
+	}
+	L.RevokeInviteCode()
+	MarkLobbyDirty(L.id)
+
+	L.lobbyMutex.Lock()
+	members := append([]SteamID(nil), L.users...)
+	spectators := append([]SteamID(nil), L.spectators...)
+	L.lobbyMutex.Unlock()
+
+	count := 0
+	for _, u := range members {
+		if u == 0 {
+			continue
+		}
+		count++
+		sessionsMutex.Lock()
+		other, ok := sessions[u]
+		sessionsMutex.Unlock()
+		if !ok {
+			continue
+		}
+		u := u
+		other.RunTask(func() {
+			if other.currentLobby != L.id {
+				return
+			}
+			other.setLobbyState(0, false)
+			other.removeUdpToken()
+			other.SendConsoleMessage(other.localized("Lobby", "房间"), other.localized(en, zh))
+			other.SendPackage(Isaacpb.ResponseHeader_LobbyChatUpdate, 0, &Isaacpb.ResponseLobbyChatUpdate{
+				SteamIdLobby:          uint64(L.id),
+				SteamIdUserChanged:    uint64(u),
+				SteamIdMakingChange:   uint64(u),
+				ChatMemberStateChange: Isaacpb.ResponseLobbyChatUpdate_Left,
+			})
+		})
+	}
+	for _, u := range spectators {
+		L.dropSpectator(u, Isaacpb.ResponseLobbyChatUpdate_Left)
+	}
+	return count
+}
+
+func lobbyLifecycleOnce() {
+	LobbyLifecycleMutex.Lock()
+	maxAge, idleTimeout, warning := MaxLobbyAge, LobbyIdleTimeout, LobbyCloseWarning
+	LobbyLifecycleMutex.Unlock()
+
+	DeleteOldLobbies()
+	reapUnjoinedLobbies()
+	pruneLobbyCreateTimes()
+	pruneJoinFailures()
+	saveLobbyIDs()
+
+	var candidates []*LobbyData
+	lobbiesMutex.Lock()
+	for _, L := range lobbies {
+		candidates = append(candidates, L)
+	}
+	lobbiesMutex.Unlock()
+
+	now := time.Now()
+	for _, L := range candidates {
+		L.lobbyMutex.Lock()
+		empty := L.UserCount() == 0
+		L.lobbyMutex.Unlock()
+		if empty || L.isPermanent() {
+			continue
+		}
+		deadline, idle := L.closeDeadline(maxAge, idleTimeout)
+		if deadline.IsZero() {
+			continue
+		}
+
+		if !now.Before(deadline) {
+			en, zh, why := "The lobby is closed because it has been open for too long", "房间开放时间过长，已被关闭", "too old"
+			if idle {
+				en, zh, why = "The lobby is closed because nobody used it for a while", "房间长时间无人使用，已被关闭", "idle"
+			}
+			members := L.closeLobby(en, zh)
+			lobbyLifecycleStatsMutex.Lock()
+			if idle {
+				lobbyLifecycleStats.IdleClosed++
+			} else {
+				lobbyLifecycleStats.AgeClosed++
+			}
+			lobbyLifecycleStatsMutex.Unlock()
+			NotifyAdmins(fmt.Sprint("lobby ", L.name, "(", L.id, ") is closed, ", why, ", members:", members))
+			continue
+		}
+
+		L.lobbyMutex.Lock()
+		warn := !L.closeWarned && now.After(deadline.Add(-warning))
+		if warn {
+			L.closeWarned = true
+		} else if L.closeWarned && now.Before(deadline.Add(-warning)) {
+			// the lobby is used again
+			L.closeWarned = false
+		}
+		L.lobbyMutex.Unlock()
+		if !warn {
+			continue
+		}
+
+		left := deadline.Sub(now).Round(time.Second)
+		if idle {
+			L.SendConsoleMessageToAllUsers("Lobby", "房间",
+				fmt.Sprint("The lobby will be closed in ", left, " if nobody uses it"),
+				fmt.Sprint("房间将在", left, "后因无人使用而关闭"), 0)
+		} else {
+			L.SendConsoleMessageToAllUsers("Lobby", "房间",
+				fmt.Sprint("The lobby will be closed in ", left, ", it has been open for too long"),
+				fmt.Sprint("房间开放时间过长，将在", left, "后关闭"), 0)
+		}
+		lobbyLifecycleStatsMutex.Lock()
+		lobbyLifecycleStats.Warned++
+		lobbyLifecycleStatsMutex.Unlock()
+		log.Print("lobby ", L.id, " will be closed at ", deadline, ", idle:", idle)
+	}
+
+	lobbyLifecycleStatsMutex.Lock()
+	lobbyLifecycleStats.LastRun = now
+	lobbyLifecycleStatsMutex.Unlock()
+}
+
+func LobbyLifecycleLoop() {
+	for {
+		LobbyLifecycleMutex.Lock()
+		interval := LobbyLifecycleInterval
+		LobbyLifecycleMutex.Unlock()
+		time.Sleep(interval)
+		lobbyLifecycleOnce()
+	}
+}
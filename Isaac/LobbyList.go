@@ -0,0 +1,190 @@
+/*
+	IsaacPaperServer server program
+	Copyright (C) 2024  frto027
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU Affero General Public License as
+	published by the Free Software Foundation, either version 3 of the
+	License, or (at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU Affero General Public License for more details.
+
+	You should have received a copy of the GNU Affero General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package Isaac
+
+import (
+	"IsaacPaperServer/0xf7.top/IsaacPaperServer/Isaacpb"
+	"encoding/base64"
+	"encoding/json"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// lobbyListKey is the position of a lobby in the sorted list, the cursor of the next page is the key of the last lobby
+type lobbyListKey struct {
+	Members int    `json:"m,omitempty"`
+	Name    string `json:"n,omitempty"`
+	Id      uint64 `json:"i"`
+}
+
+func lobbyKeyOf(info *Isaacpb.LobbyInfo, sortBy Isaacpb.RequestLobbyList_LobbyListSort) lobbyListKey {
+	key := lobbyListKey{Id: info.LobbyId}
+	switch sortBy {
+	case Isaacpb.RequestLobbyList_ByMembers:
+		key.Members = lobbyInfoUserCount(info)
+	case Isaacpb.RequestLobbyList_ByName:
+		key.Name = info.Name
+	}
+	return key
+}
+
+func (a lobbyListKey) Less(b lobbyListKey, sortBy Isaacpb.RequestLobbyList_LobbyListSort) bool {
+	switch sortBy {
+	case Isaacpb.RequestLobbyList_ByMembers:
+		if a.Members != b.Members {
+			return a.Members > b.Members
+		}
+	case Isaacpb.RequestLobbyList_ByName:
+		if a.Name != b.Name {
+			return a.Name < b.Name
+		}
+	}
+	return a.Id < b.Id
+}
+
+func encodeLobbyCursor(key lobbyListKey) string {
+	bts, _ := json.Marshal(key)
+	return base64.RawURLEncoding.EncodeToString(bts)
+}
+
+func decodeLobbyCursor(cursor string) (lobbyListKey, bool) {
+	key := lobbyListKey{}
+	bts, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return key, false
+	}
+	return key, json.Unmarshal(bts, &key) == nil
+}
+
+func lobbyInfoUserCount(info *Isaacpb.LobbyInfo) int {
+	count := 0
+	for _, u := range info.UserIds {
+		if u != 0 {
+			count++
+		}
+	}
+	return count
+}
+
+func lobbyInfoData(info *Isaacpb.LobbyInfo, key string) (string, bool) {
+	for _, item := range info.Datas {
+		if item.K == key {
+			return item.V, true
+		}
+	}
+	return "", false
+}
+
+func matchLobbyFilter(info *Isaacpb.LobbyInfo, filter *Isaacpb.LobbyListFilter) bool {
+	value, ok := lobbyInfoData(info, filter.Key)
+	if !ok {
+		// like steam, a missing key only matches "not equal"
+		return filter.Comparison == Isaacpb.LobbyListFilter_NotEqual
+	}
+
+	cmp := strings.Compare(value, filter.Value)
+	if filter.Numeric {
+		a, err1 := strconv.ParseInt(value, 10, 64)
+		b, err2 := strconv.ParseInt(filter.Value, 10, 64)
+		if err1 != nil || err2 != nil {
+			return false
+		}
+		switch {
+		case a < b:
+			cmp = -1
+		case a > b:
+			cmp = 1
+		default:
+			cmp = 0
+		}
+	}
+
+	switch filter.Comparison {
+	case Isaacpb.LobbyListFilter_Equal:
+		return cmp == 0
+	case Isaacpb.LobbyListFilter_NotEqual:
+		return cmp != 0
+	case Isaacpb.LobbyListFilter_LessThan:
+		return cmp < 0
+	case Isaacpb.LobbyListFilter_LessThanOrEqual:
+		return cmp <= 0
+	case Isaacpb.LobbyListFilter_GreaterThan:
+		return cmp > 0
+	case Isaacpb.LobbyListFilter_GreaterThanOrEqual:
+		return cmp >= 0
+	}
+	return false
+}
+
+func matchLobbyListRequest(info *Isaacpb.LobbyInfo, msg *Isaacpb.RequestLobbyList) bool {
+	if info.LobbyGroup != msg.LobbyGroup {
+		return false
+	}
+	if msg.HideFull && lobbyInfoUserCount(info) >= len(info.UserIds) {
+		return false
+	}
+	if msg.HideLocked && (info.HasPassword || info.NotJoinable) {
+		return false
+	}
+	if len(msg.NameContains) > 0 && !strings.Contains(strings.ToLower(info.Name), strings.ToLower(msg.NameContains)) {
+		return false
+	}
+	for _, filter := range msg.Filters {
+		if !matchLobbyFilter(info, filter) {
+			return false
+		}
+	}
+	return true
+}
+
+// BuildLobbyList filters, sorts and paginates the lobbies the viewer can see for the request
+func BuildLobbyList(msg *Isaacpb.RequestLobbyList, viewer SteamID) *Isaacpb.ResponseLobbyList {
+	infos := cachedLobbyInfos()
+
+	matched := make([]*Isaacpb.LobbyInfo, 0, len(infos))
+	for _, info := range infos {
+		if matchLobbyListRequest(info, msg) && lobbyVisibleTo(info, viewer) {
+			matched = append(matched, info)
+		}
+	}
+	keys := make(map[*Isaacpb.LobbyInfo]lobbyListKey, len(matched))
+	for _, info := range matched {
+		keys[info] = lobbyKeyOf(info, msg.Sort)
+	}
+	sort.Slice(matched, func(i, j int) bool {
+		return keys[matched[i]].Less(keys[matched[j]], msg.Sort)
+	})
+
+	r := Isaacpb.ResponseLobbyList{TotalCount: uint32(len(matched))}
+
+	start := 0
+	if cursor, ok := decodeLobbyCursor(msg.Cursor); len(msg.Cursor) > 0 && ok {
+		start = sort.Search(len(matched), func(i int) bool {
+			return cursor.Less(keys[matched[i]], msg.Sort)
+		})
+	}
+	end := len(matched)
+	if msg.PageSize > 0 && start+int(msg.PageSize) < end {
+		end = start + int(msg.PageSize)
+		r.NextCursor = encodeLobbyCursor(keys[matched[end-1]])
+	}
+	r.Lobbies = matched[start:end]
+	return &r
+}
@@ -0,0 +1,282 @@
+/*
+	IsaacPaperServer server program
+	Copyright (C) 2024  frto027
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU Affero General Public License as
+	published by the Free Software Foundation, either version 3 of the
+	License, or (at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU Affero General Public License for more details.
+
+	You should have received a copy of the GNU Affero General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package Isaac
+
+import (
+	"IsaacPaperServer/0xf7.top/IsaacPaperServer/Isaacpb"
+	list2 "container/list"
+	"google.golang.org/protobuf/proto"
+	"sync"
+	"time"
+)
+
+// The lobby list is cached as a versioned snapshot. Changed lobbies are marked dirty and rebuilt once,
+// then the changes are pushed to the subscribed sessions as a delta.
+
+var LobbyListPushInterval = time.Millisecond * 500
+
+type lobbyListSubscriber struct {
+	session     *SessionData
+	group       int32
+	lastVersion uint64
+	shown       map[LobbyID]bool // the lobbies the subscriber knows, a lobby can become hidden to it
+}
+
+var (
+	lobbyListVersion     = uint64(0)
+	lobbyListSnapshot    = map[LobbyID]*Isaacpb.LobbyInfo{}
+	dirtyLobbies         = map[LobbyID]bool{}
+	lobbyListSubscribers = map[SteamID]*lobbyListSubscriber{}
+	lobbyListCacheMutex  = sync.Mutex{}
+
+	// only one goroutine rebuilds the dirty lobbies and pushes the changes at a time,
+	// so the deltas are sent in order
+	lobbyListRefreshMutex = sync.Mutex{}
+)
+
+// MarkLobbyDirty should be called after anything in the LobbyInfo of the lobby is changed, or the lobby is removed
+func MarkLobbyDirty(id LobbyID) {
+	lobbyListCacheMutex.Lock()
+	dirtyLobbies[id] = true
+	lobbyListCacheMutex.Unlock()
+}
+
+type lobbyListDelta struct {
+	baseVersion uint64
+	version     uint64
+	added       []*Isaacpb.LobbyInfo
+	updated     []*Isaacpb.LobbyInfo
+	removed     []*Isaacpb.LobbyInfo                // the last known info of the removed lobbies
+	patches     map[LobbyID]*Isaacpb.LobbyInfoPatch // the compact form of the updated lobbies, if any
+}
+
+// lobbyInfoPatch returns the changes from old to info, false if something else than the members or
+// the data is changed, the full info must be sent then
+func lobbyInfoPatch(old *Isaacpb.LobbyInfo, info *Isaacpb.LobbyInfo) (*Isaacpb.LobbyInfoPatch, bool) {
+	a, b := proto.Clone(old).(*Isaacpb.LobbyInfo), proto.Clone(info).(*Isaacpb.LobbyInfo)
+	a.UserIds, b.UserIds, a.Datas, b.Datas = nil, nil, nil, nil
+	if !proto.Equal(a, b) {
+		return nil, false
+	}
+
+	patch := Isaacpb.LobbyInfoPatch{LobbyId: info.LobbyId}
+	membersChanged := len(old.UserIds) != len(info.UserIds)
+	for i, u := range info.UserIds {
+		if u != 0 {
+			patch.MemberCount++
+		}
+		if !membersChanged && old.UserIds[i] != u {
+			membersChanged = true
+		}
+	}
+	if membersChanged {
+		patch.UserIds = info.UserIds
+	}
+
+	oldData := map[string]string{}
+	for _, kv := range old.Datas {
+		oldData[kv.K] = kv.V
+	}
+	for _, kv := range info.Datas {
+		if v, ok := oldData[kv.K]; !ok || v != kv.V {
+			patch.Datas = append(patch.Datas, kv)
+		}
+		delete(oldData, kv.K)
+	}
+	for k := range oldData {
+		patch.DeletedKeys = append(patch.DeletedKeys, k)
+	}
+	return &patch, true
+}
+
+// updateLobbyList rebuilds the dirty lobbies and pushes the changes to the subscribers
+func updateLobbyList() {
+	lobbyListRefreshMutex.Lock()
+	defer lobbyListRefreshMutex.Unlock()
+	if delta := refreshLobbyListNoLock(); delta != nil {
+		pushLobbyListDelta(delta)
+	}
+}
+
+// refreshLobbyListNoLock returns nil if nothing is changed, lobbyListRefreshMutex must be held
+func refreshLobbyListNoLock() *lobbyListDelta {
+	lobbyListCacheMutex.Lock()
+	dirty := dirtyLobbies
+	dirtyLobbies = map[LobbyID]bool{}
+	lobbyListCacheMutex.Unlock()
+
+	if len(dirty) == 0 {
+		return nil
+	}
+
+	// build the infos without holding the cache lock, MarkLobbyDirty may be called with the lobby locks held
+	infos := map[LobbyID]*Isaacpb.LobbyInfo{}
+	lobbiesMutex.Lock()
+	for id := range dirty {
+		if L, ok := lobbies[id]; ok {
+			infos[id] = L.ToProtobufLobbyInfo()
+		}
+	}
+	lobbiesMutex.Unlock()
+
+	lobbyListCacheMutex.Lock()
+	defer lobbyListCacheMutex.Unlock()
+	delta := lobbyListDelta{baseVersion: lobbyListVersion, patches: map[LobbyID]*Isaacpb.LobbyInfoPatch{}}
+	for id := range dirty {
+		old, existed := lobbyListSnapshot[id]
+		info, exists := infos[id]
+		switch {
+		case exists && existed:
+			patch, ok := lobbyInfoPatch(old, info)
+			if ok && len(patch.UserIds)+len(patch.Datas)+len(patch.DeletedKeys) == 0 {
+				continue // nothing is changed
+			}
+			delta.updated = append(delta.updated, info)
+			if ok {
+				delta.patches[id] = patch
+			}
+			lobbyListSnapshot[id] = info
+		case exists:
+			delta.added = append(delta.added, info)
+			lobbyListSnapshot[id] = info
+		case existed:
+			delta.removed = append(delta.removed, old)
+			delete(lobbyListSnapshot, id)
+		}
+	}
+	if len(delta.added)+len(delta.updated)+len(delta.removed) == 0 {
+		return nil
+	}
+	lobbyListVersion++
+	delta.version = lobbyListVersion
+	return &delta
+}
+
+// cachedLobbyInfos returns the up-to-date lobby list, the infos must not be modified
+func cachedLobbyInfos() []*Isaacpb.LobbyInfo {
+	updateLobbyList()
+
+	lobbyListCacheMutex.Lock()
+	infos := make([]*Isaacpb.LobbyInfo, 0, len(lobbyListSnapshot))
+	for _, info := range lobbyListSnapshot {
+		infos = append(infos, info)
+	}
+	lobbyListCacheMutex.Unlock()
+	return infos
+}
+
+func pushLobbyListDelta(delta *lobbyListDelta) {
+	type push struct {
+		session *SessionData
+		pkg     *Isaacpb.ResponseLobbyListDelta
+	}
+	pushes := list2.New()
+
+	lobbyListCacheMutex.Lock()
+	for _, sub := range lobbyListSubscribers {
+		pkg := Isaacpb.ResponseLobbyListDelta{
+			BaseVersion: sub.lastVersion,
+			Version:     delta.version,
+		}
+		viewer := sub.session.steamId
+		for _, info := range append(append([]*Isaacpb.LobbyInfo(nil), delta.added...), delta.updated...) {
+			id := LobbyID(info.LobbyId)
+			switch visible := info.LobbyGroup == sub.group && lobbyVisibleTo(info, viewer); {
+			case visible && sub.shown[id]:
+				if patch, ok := delta.patches[id]; ok {
+					pkg.Patched = append(pkg.Patched, patch)
+				} else {
+					pkg.Updated = append(pkg.Updated, info)
+				}
+			case visible:
+				pkg.Added = append(pkg.Added, info)
+				sub.shown[id] = true
+			case sub.shown[id]:
+				pkg.Removed = append(pkg.Removed, info.LobbyId)
+				delete(sub.shown, id)
+			}
+		}
+		for _, info := range delta.removed {
+			if sub.shown[LobbyID(info.LobbyId)] {
+				pkg.Removed = append(pkg.Removed, info.LobbyId)
+				delete(sub.shown, LobbyID(info.LobbyId))
+			}
+		}
+		if len(pkg.Added)+len(pkg.Updated)+len(pkg.Removed)+len(pkg.Patched) == 0 {
+			continue
+		}
+		sub.lastVersion = delta.version
+		pushes.PushBack(push{session: sub.session, pkg: &pkg})
+	}
+	lobbyListCacheMutex.Unlock()
+
+	for it := pushes.Front(); it != nil; it = it.Next() {
+		p := it.Value.(push)
+		p.session.SendPackage(Isaacpb.ResponseHeader_LobbyListDelta, 0, p.pkg)
+	}
+}
+
+// SubscribeLobbyList sends the snapshot of the lobby group, then the changes will be pushed to the session
+func (s *SessionData) SubscribeLobbyList(subscribe bool, group int32) bool {
+	if !subscribe {
+		lobbyListCacheMutex.Lock()
+		// the user may have logged in again with a new session
+		if sub, ok := lobbyListSubscribers[s.steamId]; ok && sub.session == s {
+			delete(lobbyListSubscribers, s.steamId)
+		}
+		lobbyListCacheMutex.Unlock()
+		return true
+	}
+
+	lobbyListRefreshMutex.Lock()
+	defer lobbyListRefreshMutex.Unlock()
+	if delta := refreshLobbyListNoLock(); delta != nil {
+		pushLobbyListDelta(delta)
+	}
+
+	lobbyListCacheMutex.Lock()
+	pkg := Isaacpb.ResponseLobbyListDelta{
+		Version:  lobbyListVersion,
+		Snapshot: true,
+	}
+	shown := map[LobbyID]bool{}
+	for id, info := range lobbyListSnapshot {
+		if info.LobbyGroup == group && lobbyVisibleTo(info, s.steamId) {
+			pkg.Added = append(pkg.Added, info)
+			shown[id] = true
+		}
+	}
+	lobbyListSubscribers[s.steamId] = &lobbyListSubscriber{
+		session:     s,
+		group:       group,
+		lastVersion: lobbyListVersion,
+		shown:       shown,
+	}
+	lobbyListCacheMutex.Unlock()
+
+	// no delta can be pushed before the snapshot, the refresh lock is held
+	return s.SendPackage(Isaacpb.ResponseHeader_LobbyListDelta, 0, &pkg)
+}
+
+func LobbyListPushLoop() {
+	for {
+		time.Sleep(LobbyListPushInterval)
+		updateLobbyList()
+	}
+}
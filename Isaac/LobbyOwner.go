@@ -0,0 +1,360 @@
+/*
+	IsaacPaperServer server program
+	Copyright (C) 2024  frto027
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU Affero General Public License as
+	published by the Free Software Foundation, either version 3 of the
+	License, or (at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU Affero General Public License for more details.
+
+	You should have received a copy of the GNU Affero General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package Isaac
+
+import (
+	"IsaacPaperServer/0xf7.top/IsaacPaperServer/Isaacpb"
+	"fmt"
+	"log"
+	"strconv"
+)
+
+// ownedLobby finds the lobby, the returned string is the reason if s is not its owner
+func (s *SessionData) ownedLobby(id LobbyID) (*LobbyData, string) {
+	lobbiesMutex.Lock()
+	L, ok := lobbies[id]
+	lobbiesMutex.Unlock()
+	if !ok {
+		return nil, s.localized("The lobby does not exist", "房间不存在")
+	}
+	L.lobbyMutex.Lock()
+	owner := L.owner
+	L.lobbyMutex.Unlock()
+	if owner != s.steamId {
+		return nil, s.localized("Only the lobby owner can do this", "只有房主可以进行此操作")
+	}
+	return L, ""
+}
+
+// removeMember takes a user out of the lobby because of the owner, state is Kicked or Banned
+func (L *LobbyData) removeMember(target SteamID, by SteamID, state Isaacpb.ResponseLobbyChatUpdate_ChatMemberStateChange) {
+	// everyone, including the target, knows what happened
+	L.SendPackageToAllUsers(Isaacpb.ResponseHeader_LobbyChatUpdate, 0, &Isaacpb.ResponseLobbyChatUpdate{
+		SteamIdLobby:               uint64(L.id),
+		SteamIdUserChanged:         uint64(target),
+		SteamIdMakingChange:        uint64(by),
+		SteamIdMakingChangeIsLobby: false,
+		SteamIdUserChangedIsLobby:  false,
+		ChatMemberStateChange:      state,
+	}, 0)
+
+	L.lobbyMutex.Lock()
+	L.RemoveUser(target)
+	L.lobbyMutex.Unlock()
+	MarkLobbyDirty(L.id)
+
+	sessionsMutex.Lock()
+	other, ok := sessions[target]
+	sessionsMutex.Unlock()
+	if !ok {
+		return
+	}
+	other.RunTask(func() {
+		if other.currentLobby != L.id {
+			return
+		}
+		other.setLobbyState(0, false)
+		other.removeUdpToken()
+		if state == Isaacpb.ResponseLobbyChatUpdate_Banned {
+			other.SendConsoleMessage(other.localized("Lobby", "房间"), other.localized(
+				"You are banned from the lobby by the owner", "您被房主封禁，无法再加入此房间"))
+		} else {
+			other.SendConsoleMessage(other.localized("Lobby", "房间"), other.localized(
+				"You are kicked from the lobby by the owner", "您被房主踢出了房间"))
+		}
+	})
+}
+
+func (s *SessionData) KickLobbyMember(id LobbyID, target SteamID) string {
+	L, reason := s.ownedLobby(id)
+	if L == nil {
+		return reason
+	}
+	if target == s.steamId {
+		return s.localized("You can't kick yourself", "您不能踢出自己")
+	}
+	L.lobbyMutex.Lock()
+	inLobby := L.HasUser(target)
+	isSpectator := L.HasSpectator(target)
+	if isSpectator {
+		L.RemoveSpectator(target)
+	}
+	L.lobbyMutex.Unlock()
+	if target == 0 || (!inLobby && !isSpectator) {
+		return s.localized("The player is not in the lobby", "该玩家不在房间中")
+	}
+
+	log.Print("user ", s.name, "(", s.steamId, ") kicks ", target, " from lobby ", L.id)
+	if isSpectator {
+		L.dropSpectator(target, Isaacpb.ResponseLobbyChatUpdate_Kicked)
+	} else {
+		L.removeMember(target, s.steamId, Isaacpb.ResponseLobbyChatUpdate_Kicked)
+	}
+	return ""
+}
+
+func (s *SessionData) BanLobbyMember(id LobbyID, target SteamID, unban bool) string {
+	L, reason := s.ownedLobby(id)
+	if L == nil {
+		return reason
+	}
+	if target == s.steamId || target == 0 {
+		return s.localized("You can't ban this player", "您不能封禁该玩家")
+	}
+
+	L.lobbyMutex.Lock()
+	if unban {
+		delete(L.bans, target)
+	} else {
+		L.bans[target] = true
+	}
+	inLobby := L.HasUser(target)
+	isSpectator := L.HasSpectator(target)
+	if isSpectator {
+		L.RemoveSpectator(target)
+	}
+	L.lobbyMutex.Unlock()
+
+	if unban {
+		log.Print("user ", s.name, "(", s.steamId, ") unbans ", target, " from lobby ", L.id)
+		return ""
+	}
+	log.Print("user ", s.name, "(", s.steamId, ") bans ", target, " from lobby ", L.id)
+	if inLobby {
+		L.removeMember(target, s.steamId, Isaacpb.ResponseLobbyChatUpdate_Banned)
+	}
+	if isSpectator {
+		L.dropSpectator(target, Isaacpb.ResponseLobbyChatUpdate_Banned)
+	}
+	return ""
+}
+
+func (s *SessionData) SetLobbyPassword(id LobbyID, password *string) string {
+	L, reason := s.ownedLobby(id)
+	if L == nil {
+		return reason
+	}
+	L.lobbyMutex.Lock()
+	L.password = hashLobbyPassword(password)
+	L.joinFailures = joinFailures{}
+	L.lobbyMutex.Unlock()
+	MarkLobbyDirty(L.id)
+	log.Print("user ", s.name, "(", s.steamId, ") changes the password of lobby ", L.id, ", locked:", password != nil)
+	return ""
+}
+
+func (s *SessionData) SetLobbyJoinable(id LobbyID, joinable bool) string {
+	L, reason := s.ownedLobby(id)
+	if L == nil {
+		return reason
+	}
+	L.lobbyMutex.Lock()
+	L.joinable = joinable
+	L.lobbyMutex.Unlock()
+	MarkLobbyDirty(L.id)
+	log.Print("user ", s.name, "(", s.steamId, ") sets lobby ", L.id, " joinable:", joinable)
+	return ""
+}
+
+func (s *SessionData) TransferLobbyOwner(id LobbyID, target SteamID) string {
+	L, reason := s.ownedLobby(id)
+	if L == nil {
+		return reason
+	}
+	if target == s.steamId {
+		return s.localized("You are already the owner", "您已经是房主了")
+	}
+	L.lobbyMutex.Lock()
+	inLobby := target != 0 && L.HasUser(target)
+	if inLobby {
+		L.owner = target
+	}
+	L.lobbyMutex.Unlock()
+	if !inLobby {
+		return s.localized("The player is not in the lobby", "该玩家不在房间中")
+	}
+	MarkLobbyDirty(L.id)
+
+	L.notifyOwnerChanged(s.steamId)
+	return ""
+}
+
+// notifyOwnerChanged tells all members that the lobby has a new owner
+func (L *LobbyData) notifyOwnerChanged(oldOwner SteamID) {
+	info := L.ToProtobufLobbyInfoWithUserData()
+	log.Print("the owner of lobby ", L.id, " is changed from ", oldOwner, " to ", info.OwnerId)
+	L.SendPackageToAllUsers(Isaacpb.ResponseHeader_LobbyOwnerChanged, 0, &Isaacpb.ResponseLobbyOwnerChanged{
+		SteamIdLobby:    uint64(L.id),
+		SteamIdOldOwner: uint64(oldOwner),
+		SteamIdNewOwner: info.OwnerId,
+		LobbyInfo:       info,
+	}, 0)
+}
+
+func (L *LobbyData) IsBanned(user SteamID) bool {
+	L.lobbyMutex.Lock()
+	defer L.lobbyMutex.Unlock()
+	return L.bans[user]
+}
+
+// the owner can also moderate the lobby with chat commands
+
+// findLobbyMember looks up a member of the lobby by steam ID or by name
+func (L *LobbyData) findLobbyMember(name string) (SteamID, bool) {
+	L.lobbyMutex.Lock()
+	users := append(append([]SteamID(nil), L.users...), L.spectators...)
+	L.lobbyMutex.Unlock()
+
+	if id, err := strconv.ParseUint(name, 10, 64); err == nil {
+		for _, u := range users {
+			if u != 0 && u == SteamID(id) {
+				return u, true
+			}
+		}
+	}
+	for _, u := range users {
+		if u == 0 {
+			continue
+		}
+		sessionsMutex.Lock()
+		other, ok := sessions[u]
+		sessionsMutex.Unlock()
+		if ok && other.name == name {
+			return u, true
+		}
+	}
+	return 0, false
+}
+
+func (s *SessionData) ownerCommandTarget(args string) (SteamID, string) {
+	lobbiesMutex.Lock()
+	L, ok := lobbies[s.currentLobby]
+	lobbiesMutex.Unlock()
+	if !ok {
+		return 0, s.localized("You are not in a lobby", "您不在房间中")
+	}
+	if len(args) == 0 {
+		return 0, s.localized("Please specify a player", "请指定一名玩家")
+	}
+	target, ok := L.findLobbyMember(args)
+	if !ok {
+		return 0, s.localized(fmt.Sprint("The player ", args, " is not in the lobby"), fmt.Sprint("玩家", args, "不在房间中"))
+	}
+	return target, ""
+}
+
+func ownerCommandReply(s *SessionData, reason string) string {
+	if len(reason) > 0 {
+		return reason
+	}
+	return s.localized("Done", "操作成功")
+}
+
+func init() {
+	RegisterChatCommand(&simpleChatCommand{
+		name:   "kick",
+		helpEn: "/kick <player>  (owner) kick a player from the lobby",
+		helpZh: "/kick <玩家>  (房主)将玩家踢出房间",
+		run: func(s *SessionData, args string) string {
+			target, reason := s.ownerCommandTarget(args)
+			if target == 0 {
+				return reason
+			}
+			return ownerCommandReply(s, s.KickLobbyMember(s.currentLobby, target))
+		},
+	})
+	RegisterChatCommand(&simpleChatCommand{
+		name:   "ban",
+		helpEn: "/ban <player>  (owner) ban a player from the lobby",
+		helpZh: "/ban <玩家>  (房主)将玩家封禁出房间",
+		run: func(s *SessionData, args string) string {
+			target, reason := s.ownerCommandTarget(args)
+			if target == 0 {
+				return reason
+			}
+			return ownerCommandReply(s, s.BanLobbyMember(s.currentLobby, target, false))
+		},
+	})
+	RegisterChatCommand(&simpleChatCommand{
+		name:   "unban",
+		helpEn: "/unban <steamid>  (owner) allow a banned player to join again",
+		helpZh: "/unban <steamid>  (房主)解除玩家的封禁",
+		run: func(s *SessionData, args string) string {
+			id, err := strconv.ParseUint(args, 10, 64)
+			if err != nil {
+				return s.localized("usage: /unban <steamid>", "用法：/unban <steamid>")
+			}
+			return ownerCommandReply(s, s.BanLobbyMember(s.currentLobby, SteamID(id), true))
+		},
+	})
+	RegisterChatCommand(&simpleChatCommand{
+		name:   "owner",
+		helpEn: "/owner <player>  (owner) make another member the lobby owner",
+		helpZh: "/owner <玩家>  (房主)将房主转让给其他成员",
+		run: func(s *SessionData, args string) string {
+			target, reason := s.ownerCommandTarget(args)
+			if target == 0 {
+				return reason
+			}
+			return ownerCommandReply(s, s.TransferLobbyOwner(s.currentLobby, target))
+		},
+	})
+	RegisterChatCommand(&simpleChatCommand{
+		name:   "password",
+		helpEn: "/password [password]  (owner) change the lobby password, or clear it",
+		helpZh: "/password [密码]  (房主)修改或清除房间密码",
+		run: func(s *SessionData, args string) string {
+			if len(args) == 0 {
+				return ownerCommandReply(s, s.SetLobbyPassword(s.currentLobby, nil))
+			}
+			password := args
+			return ownerCommandReply(s, s.SetLobbyPassword(s.currentLobby, &password))
+		},
+	})
+	RegisterChatCommand(&simpleChatCommand{
+		name:   "spectators",
+		helpEn: "/spectators on|off  (owner) allow or forbid spectators",
+		helpZh: "/spectators on|off  (房主)允许或禁止旁观",
+		run: func(s *SessionData, args string) string {
+			switch args {
+			case "on":
+				return ownerCommandReply(s, s.SetLobbySpectators(s.currentLobby, true))
+			case "off":
+				return ownerCommandReply(s, s.SetLobbySpectators(s.currentLobby, false))
+			}
+			return s.localized("usage: /spectators on|off", "用法：/spectators on|off")
+		},
+	})
+	RegisterChatCommand(&simpleChatCommand{
+		name:   "lock",
+		helpEn: "/lock  (owner) don't allow anyone to join the lobby",
+		helpZh: "/lock  (房主)禁止任何人加入房间",
+		run: func(s *SessionData, _ string) string {
+			return ownerCommandReply(s, s.SetLobbyJoinable(s.currentLobby, false))
+		},
+	})
+	RegisterChatCommand(&simpleChatCommand{
+		name:   "unlock",
+		helpEn: "/unlock  (owner) allow players to join the lobby again",
+		helpZh: "/unlock  (房主)重新允许玩家加入房间",
+		run: func(s *SessionData, _ string) string {
+			return ownerCommandReply(s, s.SetLobbyJoinable(s.currentLobby, true))
+		},
+	})
+}
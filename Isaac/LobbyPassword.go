@@ -0,0 +1,212 @@
+/*
+	IsaacPaperServer server program
+	Copyright (C) 2024  frto027
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU Affero General Public License as
+	published by the Free Software Foundation, either version 3 of the
+	License, or (at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU Affero General Public License for more details.
+
+	You should have received a copy of the GNU Affero General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package Isaac
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"fmt"
+	"log"
+	"net"
+	"net/netip"
+	"sync"
+	"time"
+)
+
+// the server never keeps the plaintext password of a lobby
+type lobbyPassword struct {
+	salt [16]byte
+	hash [32]byte
+}
+
+var (
+	// a user can fail UserJoinFailLimit times, and everyone from the same address can fail
+	// AddressJoinFailLimit times, in JoinFailWindow before being locked out. When everyone together
+	// fails LobbyJoinFailLimit times, the lobby is locked for the strangers, the members and the
+	// friends of the owner can still enter, and the others can be invited.
+	UserJoinFailLimit    = 5
+	AddressJoinFailLimit = 20
+	LobbyJoinFailLimit   = 20
+	JoinFailWindow       = time.Minute
+	JoinFailLockout      = time.Minute * 5
+	JoinFailMutex        = sync.Mutex{}
+
+	// kept by SteamID and address, so reconnecting doesn't reset them
+	userJoinFailures    = map[SteamID]*joinFailures{}
+	addressJoinFailures = map[netip.Addr]*joinFailures{}
+	joinFailuresMutex   = sync.Mutex{}
+)
+
+func saltedPasswordHash(salt []byte, password string) [32]byte {
+	h := sha256.New()
+	h.Write(salt)
+	h.Write([]byte(password))
+	var sum [32]byte
+	copy(sum[:], h.Sum(nil))
+	return sum
+}
+
+// hashLobbyPassword returns nil if there is no password
+func hashLobbyPassword(password *string) *lobbyPassword {
+	if password == nil {
+		return nil
+	}
+	p := &lobbyPassword{}
+	if _, err := rand.Read(p.salt[:]); err != nil {
+		log.Panic(err)
+	}
+	p.hash = saltedPasswordHash(p.salt[:], *password)
+	return p
+}
+
+func (p *lobbyPassword) Check(password *string) bool {
+	if password == nil {
+		return false
+	}
+	hash := saltedPasswordHash(p.salt[:], *password)
+	return subtle.ConstantTimeCompare(hash[:], p.hash[:]) == 1
+}
+
+// joinFailures tracks the failed password attempts of a user, an address or a lobby
+type joinFailures struct {
+	times       []time.Time
+	lockedUntil time.Time
+}
+
+func (f *joinFailures) stale(now time.Time, window time.Duration) bool {
+	return !now.Before(f.lockedUntil) && (len(f.times) == 0 || now.Sub(f.times[len(f.times)-1]) >= window)
+}
+
+// record returns true if the failure starts a lockout
+func (f *joinFailures) record(now time.Time, limit int, window time.Duration, lockout time.Duration) bool {
+	for len(f.times) > 0 && now.Sub(f.times[0]) >= window {
+		f.times = f.times[1:]
+	}
+	f.times = append(f.times, now)
+	if limit > 0 && len(f.times) >= limit {
+		f.times = nil
+		f.lockedUntil = now.Add(lockout)
+		return true
+	}
+	return false
+}
+
+// remoteIP returns the address the session connects from, or an invalid one if it is unknown
+func (s *SessionData) remoteIP() netip.Addr {
+	if addr, ok := s.conn.RemoteAddr().(*net.TCPAddr); ok {
+		return addr.AddrPort().Addr().Unmap()
+	}
+	return netip.Addr{}
+}
+
+// checkLobbyPassword returns the reason if the user can't try the password now, and whether the password is correct
+func (s *SessionData) checkLobbyPassword(L *LobbyData, password *string) (string, bool) {
+	JoinFailMutex.Lock()
+	userLimit, addressLimit, lobbyLimit, window, lockout := UserJoinFailLimit, AddressJoinFailLimit, LobbyJoinFailLimit, JoinFailWindow, JoinFailLockout
+	JoinFailMutex.Unlock()
+
+	L.lobbyMutex.Lock()
+	open := L.password == nil || L.owner == s.steamId || L.UserPosition(s.steamId) != -1
+	L.lobbyMutex.Unlock()
+	if open {
+		// the creator joins the lobby it is already in
+		return "", true
+	}
+
+	now := time.Now()
+	ip := s.remoteIP()
+	joinFailuresMutex.Lock()
+	user, address := userJoinFailures[s.steamId], addressJoinFailures[ip]
+	if user == nil {
+		user = &joinFailures{}
+		userJoinFailures[s.steamId] = user
+	}
+	if address == nil {
+		address = &joinFailures{}
+		if ip.IsValid() {
+			addressJoinFailures[ip] = address
+		}
+	}
+	lockedUntil := user.lockedUntil
+	if address.lockedUntil.After(lockedUntil) {
+		lockedUntil = address.lockedUntil
+	}
+	joinFailuresMutex.Unlock()
+	if now.Before(lockedUntil) {
+		return s.localized(
+			fmt.Sprint("Too many wrong passwords, please try again after ", lockedUntil.Format(time.TimeOnly)),
+			fmt.Sprint("密码错误次数过多，请在", lockedUntil.Format(time.TimeOnly), "后再试")), false
+	}
+
+	L.lobbyMutex.Lock()
+	if now.Before(L.joinFailures.lockedUntil) && (L.owner == 0 || !IsFriend(L.owner, s.steamId)) {
+		L.lobbyMutex.Unlock()
+		return s.localized(
+			"The lobby is locked because of too many wrong passwords, please try again later or ask for an invite",
+			"此房间的密码错误次数过多，已被暂时锁定，请稍后再试或请求邀请"), false
+	}
+	if L.password == nil || L.password.Check(password) {
+		L.lobbyMutex.Unlock()
+		return "", true
+	}
+	lobbyLocked := L.joinFailures.record(now, lobbyLimit, window, lockout)
+	L.lobbyMutex.Unlock()
+
+	joinFailuresMutex.Lock()
+	userLocked := user.record(now, userLimit, window, lockout)
+	addressLocked := address.record(now, addressLimit, window, lockout)
+	joinFailuresMutex.Unlock()
+	log.Print("user ", s.name, "(", s.steamId, ") tries a wrong password of lobby ", L.id)
+
+	if userLocked {
+		NotifyAdmins(fmt.Sprint("user ", s.name, "(", s.steamId, ") from ", ip,
+			" is locked out for ", lockout, " after ", userLimit, " wrong lobby passwords"))
+	}
+	if addressLocked {
+		NotifyAdmins(fmt.Sprint("address ", ip, " is locked out for ", lockout,
+			" after ", addressLimit, " wrong lobby passwords, the last one is from ", s.name, "(", s.steamId, ")"))
+	}
+	if lobbyLocked {
+		NotifyAdmins(fmt.Sprint("lobby ", L.name, "(", L.id, ") is locked for the strangers for ", lockout,
+			" after ", lobbyLimit, " wrong passwords, the last one is from ", s.name, "(", s.steamId, ")"))
+	}
+	return "", false
+}
+
+// pruneJoinFailures forgets the users and the addresses that are not locked and didn't fail recently
+func pruneJoinFailures() {
+	JoinFailMutex.Lock()
+	window := JoinFailWindow
+	JoinFailMutex.Unlock()
+
+	now := time.Now()
+	joinFailuresMutex.Lock()
+	for user, f := range userJoinFailures {
+		if f.stale(now, window) {
+			delete(userJoinFailures, user)
+		}
+	}
+	for ip, f := range addressJoinFailures {
+		if f.stale(now, window) {
+			delete(addressJoinFailures, ip)
+		}
+	}
+	joinFailuresMutex.Unlock()
+}
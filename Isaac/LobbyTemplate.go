@@ -0,0 +1,226 @@
+/*
+	IsaacPaperServer server program
+	Copyright (C) 2024  frto027
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU Affero General Public License as
+	published by the Free Software Foundation, either version 3 of the
+	License, or (at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU Affero General Public License for more details.
+
+	You should have received a copy of the GNU Affero General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package Isaac
+
+import (
+	"IsaacPaperServer/0xf7.top/IsaacPaperServer/Isaacpb"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// LobbyTemplate describes the lobbies created by the server, or by the users who pick a template
+type LobbyTemplate struct {
+	Name       string                  `json:"name"`               // "{n}" is replaced by a number to make the name unique
+	Capacity   int                     `json:"capacity,omitempty"` // 0 means the default lobby size
+	EnableP2P  bool                    `json:"p2p"`
+	Group      int32                   `json:"group"`
+	Visibility Isaacpb.LobbyVisibility `json:"visibility,omitempty"`
+	Data       map[string]string       `json:"data,omitempty"`
+}
+
+const lobbyTemplatesFile = "templates.json"
+
+// the name numbers are searched up to this, the lobby list is not that long
+const maxLobbyNameNumber = 10000
+
+var (
+	lobbyTemplates      = map[string]*LobbyTemplate{}
+	lobbyTemplatesMutex = sync.Mutex{}
+	// the saves are written one by one, so an older config never replaces a newer one
+	lobbyTemplatesSaveMutex = sync.Mutex{}
+
+	// the generated names of the lobbies that are not in the lobby list yet
+	pendingLobbyNames      = map[string]time.Time{}
+	pendingLobbyNamesMutex = sync.Mutex{}
+)
+
+// Instantiate creates a lobby without any member, the first user who joins it becomes the owner.
+// The lobby is not added to the lobby list.
+func (T *LobbyTemplate) Instantiate(group int32, capacity int, lang Isaacpb.RequestLogin_Lang) *LobbyData {
+	if capacity <= 0 {
+		capacity = T.Capacity
+	}
+	L := &LobbyData{}
+	L.Create(LobbyCapacity(capacity))
+	L.name = uniqueLobbyName(T.Name)
+	L.enableP2P = T.EnableP2P
+	L.group = group
+	L.lang = lang
+	L.visibility = T.Visibility
+	for k, v := range T.Data {
+		L.data[k] = v
+	}
+	return L
+}
+
+// uniqueLobbyName replaces "{n}" in the pattern with the smallest number no other lobby uses
+func uniqueLobbyName(pattern string) string {
+	if !strings.Contains(pattern, "{n}") {
+		return pattern
+	}
+	used := map[string]bool{}
+	lobbiesMutex.Lock()
+	for _, L := range lobbies {
+		L.lobbyMutex.Lock()
+		used[L.name] = true
+		L.lobbyMutex.Unlock()
+	}
+	lobbiesMutex.Unlock()
+
+	now := time.Now()
+	pendingLobbyNamesMutex.Lock()
+	defer pendingLobbyNamesMutex.Unlock()
+	for name, t := range pendingLobbyNames {
+		if now.Sub(t) > time.Minute {
+			delete(pendingLobbyNames, name)
+		}
+	}
+	name := ""
+	for n := 1; n <= maxLobbyNameNumber; n++ {
+		name = strings.ReplaceAll(pattern, "{n}", strconv.Itoa(n))
+		if !used[name] && pendingLobbyNames[name].IsZero() {
+			break
+		}
+	}
+	pendingLobbyNames[name] = now
+	return name
+}
+
+// LoadLobbyTemplates reads the templates from the data directory
+func LoadLobbyTemplates() error {
+	content, err := readDataFile(lobbyTemplatesFile)
+	if err != nil || content == nil {
+		return err
+	}
+	loaded := map[string]*LobbyTemplate{}
+	if err := json.Unmarshal(content, &loaded); err != nil {
+		return errors.New(fmt.Sprint("failed to parse ", lobbyTemplatesFile, ": ", err))
+	}
+	lobbyTemplatesMutex.Lock()
+	lobbyTemplates = loaded
+	lobbyTemplatesMutex.Unlock()
+	log.Print("load ", len(loaded), " lobby templates from ", dataFile(lobbyTemplatesFile))
+	return nil
+}
+
+func saveLobbyTemplates() {
+	lobbyTemplatesSaveMutex.Lock()
+	defer lobbyTemplatesSaveMutex.Unlock()
+	if dataFile(lobbyTemplatesFile) == "" {
+		return
+	}
+
+	lobbyTemplatesMutex.Lock()
+	content, err := json.MarshalIndent(lobbyTemplates, "", "  ")
+	lobbyTemplatesMutex.Unlock()
+	if err == nil {
+		err = writeDataFile(lobbyTemplatesFile, content)
+	}
+	if err != nil {
+		log.Print("failed to save the lobby templates: ", err)
+	}
+}
+
+// FindLobbyTemplate returns a copy of the template
+func FindLobbyTemplate(key string) (LobbyTemplate, bool) {
+	lobbyTemplatesMutex.Lock()
+	defer lobbyTemplatesMutex.Unlock()
+	T, ok := lobbyTemplates[key]
+	if !ok {
+		return LobbyTemplate{}, false
+	}
+	copied := *T
+	copied.Data = map[string]string{}
+	for k, v := range T.Data {
+		copied.Data[k] = v
+	}
+	return copied, true
+}
+
+// EditLobbyTemplate creates the template if it doesn't exist, then changes it
+func EditLobbyTemplate(key string, edit func(T *LobbyTemplate) error) error {
+	T, ok := FindLobbyTemplate(key)
+	if !ok {
+		T = LobbyTemplate{Name: key, EnableP2P: true, Data: map[string]string{}}
+	}
+	if err := edit(&T); err != nil {
+		return err
+	}
+	lobbyTemplatesMutex.Lock()
+	lobbyTemplates[key] = &T
+	lobbyTemplatesMutex.Unlock()
+	saveLobbyTemplates()
+	return nil
+}
+
+func RemoveLobbyTemplate(key string) error {
+	lobbyTemplatesMutex.Lock()
+	_, ok := lobbyTemplates[key]
+	delete(lobbyTemplates, key)
+	lobbyTemplatesMutex.Unlock()
+	if !ok {
+		return errors.New("no such lobby template")
+	}
+	saveLobbyTemplates()
+	return nil
+}
+
+func lobbyTemplateKeys() []string {
+	lobbyTemplatesMutex.Lock()
+	keys := make([]string, 0, len(lobbyTemplates))
+	for key := range lobbyTemplates {
+		keys = append(keys, key)
+	}
+	lobbyTemplatesMutex.Unlock()
+	sort.Strings(keys)
+	return keys
+}
+
+// SendCreateRoomNameLists sends the name suggestions and the templates for creating a lobby
+func (s *SessionData) SendCreateRoomNameLists() bool {
+	pkg := Isaacpb.ResponseCreateRoomNameLists{}
+	DefaultLobbyNamesMutex.Lock()
+	if DefaultLobbyNames != nil {
+		pkg.Names = *DefaultLobbyNames
+	}
+	DefaultLobbyNamesMutex.Unlock()
+	for _, key := range lobbyTemplateKeys() {
+		if T, ok := FindLobbyTemplate(key); ok {
+			pkg.Templates = append(pkg.Templates, &Isaacpb.LobbyTemplateInfo{
+				Key:        key,
+				Name:       T.Name,
+				LobbyGroup: T.Group,
+				MaxMembers: int32(LobbyCapacity(T.Capacity)),
+				EnableP2P:  T.EnableP2P,
+				Visibility: T.Visibility,
+			})
+		}
+	}
+	if pkg.Names == nil && pkg.Templates == nil {
+		return true
+	}
+	return s.SendPackage(Isaacpb.ResponseHeader_UpdateCreateRoomNameLists, 0, &pkg)
+}
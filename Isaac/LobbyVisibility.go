@@ -0,0 +1,50 @@
+/*
+	IsaacPaperServer server program
+	Copyright (C) 2024  frto027
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU Affero General Public License as
+	published by the Free Software Foundation, either version 3 of the
+	License, or (at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU Affero General Public License for more details.
+
+	You should have received a copy of the GNU Affero General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package Isaac
+
+import (
+	"IsaacPaperServer/0xf7.top/IsaacPaperServer/Isaacpb"
+)
+
+func infoHasMember(info *Isaacpb.LobbyInfo, user SteamID) bool {
+	for _, u := range info.UserIds {
+		if SteamID(u) == user {
+			return true
+		}
+	}
+	for _, u := range info.SpectatorIds {
+		if SteamID(u) == user {
+			return true
+		}
+	}
+	return false
+}
+
+// lobbyVisibleTo tells if the lobby is listed to the viewer, the members can always see their lobby
+func lobbyVisibleTo(info *Isaacpb.LobbyInfo, viewer SteamID) bool {
+	switch info.Visibility {
+	case Isaacpb.LobbyVisibility_Public:
+		return true
+	case Isaacpb.LobbyVisibility_FriendsOnly:
+		if info.OwnerId != 0 && IsFriend(SteamID(info.OwnerId), viewer) {
+			return true
+		}
+	}
+	return infoHasMember(info, viewer)
+}
@@ -0,0 +1,277 @@
+/*
+	IsaacPaperServer server program
+	Copyright (C) 2024  frto027
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU Affero General Public License as
+	published by the Free Software Foundation, either version 3 of the
+	License, or (at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU Affero General Public License for more details.
+
+	You should have received a copy of the GNU Affero General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package Isaac
+
+import (
+	"IsaacPaperServer/0xf7.top/IsaacPaperServer/Isaacpb"
+	list2 "container/list"
+	"log"
+	"sync"
+	"time"
+)
+
+var (
+	MatchmakeInterval    = time.Second
+	MatchmakeTimeout     = time.Minute * 5
+	MatchmakeCreateDelay = time.Second * 10 // wait for a lobby to be opened by others before creating one
+	MatchmakeReserveTime = time.Second * 30 // how long a matched user has to join the lobby
+	MatchmakeTemplate    = LobbyTemplate{Name: "Quick match #{n}", EnableP2P: true}
+	MatchmakeMutex       = sync.Mutex{}
+
+	matchmakeQueue      []*matchmakeTicket
+	matchmakeQueueMutex = sync.Mutex{}
+)
+
+type matchmakeTicket struct {
+	session  *SessionData
+	group    int32
+	modeKeys map[string]string
+	size     int
+	lang     Isaacpb.RequestLogin_Lang
+	anyLang  bool
+	since    time.Time
+	position int // the last position told to the user
+}
+
+type matchmakeStatus struct {
+	session *SessionData
+	pkg     *Isaacpb.ResponseMatchmakeStatus
+}
+
+// freeSlotsNoLock counts the empty slots that are not reserved, L.lobbyMutex must be held
+func (L *LobbyData) freeSlotsNoLock(now time.Time) int {
+	free := len(L.users) - L.UserCount()
+	for user, until := range L.reserved {
+		if now.After(until) || L.HasUser(user) {
+			delete(L.reserved, user)
+			continue
+		}
+		free--
+	}
+	return free
+}
+
+// matchLobbyNoLock tells if the user can be placed into the lobby, L.lobbyMutex must be held.
+// The checks that would refuse the join later are done here as well.
+func (t *matchmakeTicket) matchLobbyNoLock(L *LobbyData, checkCrc bool) bool {
+	if L.group != t.group || L.visibility != Isaacpb.LobbyVisibility_Public ||
+		!L.joinable || L.password != nil || L.bans[t.session.steamId] {
+		return false
+	}
+	// the size is clamped the same way as the lobbies opened for the ticket
+	if t.size > 0 && len(L.users) != LobbyCapacity(t.size) {
+		return false
+	}
+	if !t.anyLang && L.lang != t.lang {
+		return false
+	}
+	for k, v := range t.modeKeys {
+		if L.data[k] != v {
+			return false
+		}
+	}
+	if result, _ := t.session.checkCompatibility(L.protocolVer, L.gameCrc, checkCrc); result != Isaacpb.ResponseLobbyJoin_Success {
+		return false
+	}
+	members := append(append([]SteamID(nil), L.users...), L.spectators...)
+	if result, _ := t.session.checkBlocks(members); result != Isaacpb.ResponseLobbyJoin_Success {
+		return false
+	}
+	return true
+}
+
+// Matchmake puts the user into the quick-match queue, or removes it if the request is a cancel
+func (s *SessionData) Matchmake(msg *Isaacpb.RequestMatchmake) bool {
+	if msg.Cancel {
+		if removeMatchmakeTicket(s) {
+			log.Print("user ", s.name, "(", s.steamId, ") leaves the matchmake queue")
+		}
+		return s.SendPackage(Isaacpb.ResponseHeader_MatchmakeStatus, 0, &Isaacpb.ResponseMatchmakeStatus{
+			Status: Isaacpb.ResponseMatchmakeStatus_Cancelled,
+		})
+	}
+
+	if s.currentLobby != 0 {
+		s.SendConsoleMessage(s.localized("Quick match", "快速匹配"), s.localized(
+			"Please leave the current lobby first", "请先离开当前房间"))
+		return s.SendPackage(Isaacpb.ResponseHeader_MatchmakeStatus, 0, &Isaacpb.ResponseMatchmakeStatus{
+			Status: Isaacpb.ResponseMatchmakeStatus_Failed,
+		})
+	}
+
+	ticket := &matchmakeTicket{
+		session:  s,
+		group:    msg.LobbyGroup,
+		modeKeys: map[string]string{},
+		size:     int(msg.PreferredSize),
+		lang:     msg.Lang,
+		anyLang:  msg.AnyLang,
+		since:    time.Now(),
+	}
+	for _, kv := range msg.ModeKeys {
+		ticket.modeKeys[kv.K] = kv.V
+	}
+
+	matchmakeQueueMutex.Lock()
+	replaced := false
+	for i, t := range matchmakeQueue {
+		if t.session == s {
+			// keep the place in the queue, but use the new criteria
+			ticket.since = t.since
+			matchmakeQueue[i] = ticket
+			replaced = true
+		}
+	}
+	if !replaced {
+		matchmakeQueue = append(matchmakeQueue, ticket)
+	}
+	pkg := Isaacpb.ResponseMatchmakeStatus{
+		Status:    Isaacpb.ResponseMatchmakeStatus_Queued,
+		QueueSize: uint32(len(matchmakeQueue)),
+	}
+	for i, t := range matchmakeQueue {
+		if t == ticket {
+			ticket.position = i + 1
+			pkg.QueuePosition = uint32(i + 1)
+		}
+	}
+	matchmakeQueueMutex.Unlock()
+
+	log.Print("user ", s.name, "(", s.steamId, ") joins the matchmake queue of group ", msg.LobbyGroup, ", size:", msg.PreferredSize)
+	return s.SendPackage(Isaacpb.ResponseHeader_MatchmakeStatus, 0, &pkg)
+}
+
+// removeMatchmakeTicket returns true if the user was in the queue
+func removeMatchmakeTicket(s *SessionData) bool {
+	matchmakeQueueMutex.Lock()
+	defer matchmakeQueueMutex.Unlock()
+	for i, t := range matchmakeQueue {
+		if t.session == s {
+			matchmakeQueue = append(matchmakeQueue[:i:i], matchmakeQueue[i+1:]...)
+			return true
+		}
+	}
+	return false
+}
+
+// matchmakeOnce places the queued users into lobbies, the new lobbies are created by MatchmakeTemplate
+func matchmakeOnce() {
+	MatchmakeMutex.Lock()
+	timeout, createDelay, reserveTime, template := MatchmakeTimeout, MatchmakeCreateDelay, MatchmakeReserveTime, MatchmakeTemplate
+	MatchmakeMutex.Unlock()
+	JoinCheckMutex.Lock()
+	checkCrc := JoinRequireSameGameCrc
+	JoinCheckMutex.Unlock()
+
+	lobbiesMutex.Lock()
+	candidates := make([]*LobbyData, 0, len(lobbies))
+	for _, L := range lobbies {
+		candidates = append(candidates, L)
+	}
+	lobbiesMutex.Unlock()
+
+	now := time.Now()
+	statuses := list2.New()
+	created := list2.New()
+
+	matchmakeQueueMutex.Lock()
+	remaining := make([]*matchmakeTicket, 0, len(matchmakeQueue))
+	for _, t := range matchmakeQueue {
+		s := t.session
+		if current, _ := s.LobbyState(); current != 0 {
+			// the user has joined a lobby by itself
+			statuses.PushBack(matchmakeStatus{s, &Isaacpb.ResponseMatchmakeStatus{Status: Isaacpb.ResponseMatchmakeStatus_Cancelled}})
+			continue
+		}
+		if now.Sub(t.since) > timeout {
+			statuses.PushBack(matchmakeStatus{s, &Isaacpb.ResponseMatchmakeStatus{Status: Isaacpb.ResponseMatchmakeStatus_Failed}})
+			continue
+		}
+
+		// fill the lobby with the most members first
+		var best *LobbyData
+		bestCount := -1
+		for _, L := range candidates {
+			L.lobbyMutex.Lock()
+			if t.matchLobbyNoLock(L, checkCrc) && L.freeSlotsNoLock(now) > 0 && L.UserCount() > bestCount {
+				best, bestCount = L, L.UserCount()
+			}
+			L.lobbyMutex.Unlock()
+		}
+		// the ticket stays queued if the server is full
+		if best == nil && now.Sub(t.since) >= createDelay && !lobbyTableFull(created.Len()) {
+			// nothing fits, open a new lobby and let the other users in the queue join it
+			best = template.Instantiate(t.group, t.size, t.lang)
+			for k, v := range t.modeKeys {
+				best.data[k] = v
+			}
+			candidates = append(candidates, best)
+			created.PushBack(best)
+		}
+		if best == nil {
+			remaining = append(remaining, t)
+			continue
+		}
+
+		best.lobbyMutex.Lock()
+		best.reserved[s.steamId] = now.Add(reserveTime)
+		best.lobbyMutex.Unlock()
+		log.Print("user ", s.name, "(", s.steamId, ") is matched to lobby ", best.id)
+		statuses.PushBack(matchmakeStatus{s, &Isaacpb.ResponseMatchmakeStatus{
+			Status:  Isaacpb.ResponseMatchmakeStatus_Matched,
+			LobbyId: uint64(best.id),
+		}})
+	}
+	matchmakeQueue = remaining
+	for i, t := range remaining {
+		if t.position != i+1 {
+			t.position = i + 1
+			statuses.PushBack(matchmakeStatus{t.session, &Isaacpb.ResponseMatchmakeStatus{
+				Status:        Isaacpb.ResponseMatchmakeStatus_Queued,
+				QueuePosition: uint32(i + 1),
+				QueueSize:     uint32(len(remaining)),
+			}})
+		}
+	}
+	matchmakeQueueMutex.Unlock()
+
+	for it := created.Front(); it != nil; it = it.Next() {
+		L := it.Value.(*LobbyData)
+		lobbiesMutex.Lock()
+		lobbies[L.id] = L
+		lobbiesMutex.Unlock()
+		MarkLobbyDirty(L.id)
+		log.Print("lobby ", L.name, "(", L.id, ") is created by the matchmaker, group:", L.group)
+	}
+	for it := statuses.Front(); it != nil; it = it.Next() {
+		st := it.Value.(matchmakeStatus)
+		if st.pkg.Status == Isaacpb.ResponseMatchmakeStatus_Failed {
+			st.session.SendConsoleMessage(st.session.localized("Quick match", "快速匹配"), st.session.localized(
+				"No lobby is found, please try again later", "没有找到合适的房间，请稍后再试"))
+		}
+		st.session.SendPackage(Isaacpb.ResponseHeader_MatchmakeStatus, 0, st.pkg)
+	}
+}
+
+func MatchmakeLoop() {
+	for {
+		time.Sleep(MatchmakeInterval)
+		matchmakeOnce()
+	}
+}
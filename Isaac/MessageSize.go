@@ -0,0 +1,120 @@
+/*
+	IsaacPaperServer server program
+	Copyright (C) 2024  frto027
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU Affero General Public License as
+	published by the Free Software Foundation, either version 3 of the
+	License, or (at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU Affero General Public License for more details.
+
+	You should have received a copy of the GNU Affero General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package Isaac
+
+import (
+	"IsaacPaperServer/0xf7.top/IsaacPaperServer/Isaacpb"
+	"errors"
+	"fmt"
+	"log"
+	"sync"
+)
+
+// LegacyMessageSize is the frame limit of the clients that don't tell their maxMessageSize
+const LegacyMessageSize = 4096
+
+// MinMessageSize is the smallest frame limit a client can ask for, the login is refused below it
+const MinMessageSize = 256
+
+var (
+	// the biggest frame body that the server sends, the real limit of a session is negotiated at login
+	MaxMessageSize = 64 * 1024
+	// the biggest request body that the server accepts from a client
+	MaxRequestSize = 64 * 1024
+	// the biggest response of a type, after joining the fragments
+	DefaultResponseSizeLimit = 256 * 1024
+	ResponseSizeLimits       = map[Isaacpb.ResponseHeader_ResponseMessageType]int{}
+	MessageSizeMutex         = sync.Mutex{}
+)
+
+func responseSizeLimit(messageType Isaacpb.ResponseHeader_ResponseMessageType) int {
+	MessageSizeMutex.Lock()
+	defer MessageSizeMutex.Unlock()
+	if limit, ok := ResponseSizeLimits[messageType]; ok {
+		return limit
+	}
+	return DefaultResponseSizeLimit
+}
+
+func maxRequestSize() int {
+	MessageSizeMutex.Lock()
+	defer MessageSizeMutex.Unlock()
+	return MaxRequestSize
+}
+
+// negotiateMessageSize decides the frame size of the session with the client's login info,
+// it returns an error if the client can't receive the frames the server needs to send
+func (s *SessionData) negotiateMessageSize(msg *Isaacpb.RequestLogin) error {
+	if msg.MaxMessageSize == 0 {
+		return nil // a legacy client, keep the defaults
+	}
+	if msg.MaxMessageSize < MinMessageSize {
+		return errors.New(fmt.Sprint("the max message size ", msg.MaxMessageSize, " of the client is smaller than ", MinMessageSize))
+	}
+	MessageSizeMutex.Lock()
+	s.maxMessageSize = min(int(msg.MaxMessageSize), MaxMessageSize)
+	requestSize := MaxRequestSize
+	MessageSizeMutex.Unlock()
+	s.fragments = msg.SupportFragments
+	s.negotiated = true
+
+	s.SendPackage(Isaacpb.ResponseHeader_MessageSizeLimits, 0, &Isaacpb.ResponseMessageSizeLimits{
+		MaxRequestSize: uint32(requestSize),
+		MaxMessageSize: uint32(s.maxMessageSize),
+		Fragments:      s.fragments,
+	})
+	return nil
+}
+
+// sendRequestFailed answers the request with RequestFailed. The legacy clients can't parse it,
+// they get the message in the console instead.
+func (s *SessionData) sendRequestFailed(messageType Isaacpb.ResponseHeader_ResponseMessageType, holdValue int32, reason Isaacpb.ResponseRequestFailed_Reason, message string) bool {
+	if !s.negotiated {
+		return s.SendConsoleMessage(s.localized("Request failed", "请求失败"), message)
+	}
+	return s.SendPackage(Isaacpb.ResponseHeader_RequestFailed, holdValue, &Isaacpb.ResponseRequestFailed{
+		ResponseType: messageType,
+		Reason:       reason,
+		Message:      message,
+	})
+}
+
+// sendTooLargeNoLock tells the client that the response can't be sent, so the request is not left hanging
+func (s *SessionData) sendTooLargeNoLock(messageType Isaacpb.ResponseHeader_ResponseMessageType, holdValue int32, size int) {
+	log.Print("the ", messageType, " message(", size, " bytes) to user ", s.name, "(", s.steamId, ") is too long")
+	if messageType == Isaacpb.ResponseHeader_RequestFailed || messageType == Isaacpb.ResponseHeader_ServerPublicMessage {
+		return
+	}
+	message := s.localized(fmt.Sprint("the response is too large(", size, " bytes)"), fmt.Sprint("回复过大(", size, "字节)"))
+	if !s.negotiated {
+		// the same fallback as sendRequestFailed
+		caption := s.localized("Request failed", "请求失败")
+		s.SendPackageNoLock(Isaacpb.ResponseHeader_ServerPublicMessage, 0, &Isaacpb.ResponseServerPublicMessage{
+			Type:    Isaacpb.ResponseServerPublicMessage_DisplayStringAtLogConsole,
+			Str:     &message,
+			Caption: &caption,
+		})
+		return
+	}
+	s.SendPackageNoLock(Isaacpb.ResponseHeader_RequestFailed, holdValue, &Isaacpb.ResponseRequestFailed{
+		ResponseType: messageType,
+		Reason:       Isaacpb.ResponseRequestFailed_TooLarge,
+		Message:      message,
+	})
+}
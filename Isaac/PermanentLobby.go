@@ -0,0 +1,340 @@
+/*
+	IsaacPaperServer server program
+	Copyright (C) 2024  frto027
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU Affero General Public License as
+	published by the Free Software Foundation, either version 3 of the
+	License, or (at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU Affero General Public License for more details.
+
+	You should have received a copy of the GNU Affero General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package Isaac
+
+import (
+	"IsaacPaperServer/0xf7.top/IsaacPaperServer/Isaacpb"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"sync"
+)
+
+// PermanentLobby is a lobby defined by the admin. It is opened at startup, and it is reset to
+// the definition instead of being deleted when the last member leaves.
+type PermanentLobby struct {
+	Key string `json:"key"`
+	LobbyTemplate
+	// the password can be written by hand in the config, it is replaced by passwordHash when the config is saved
+	Password     string `json:"password,omitempty"`
+	PasswordHash string `json:"passwordHash,omitempty"`
+
+	password *lobbyPassword
+	lobby    LobbyID // the lobby opened for the definition
+}
+
+const permanentLobbiesFile = "lobbies.json"
+
+var (
+	permanentLobbies []*PermanentLobby
+	// guards permanentLobbies, their fields and LobbyData.permanent
+	permanentLobbiesMutex = sync.Mutex{}
+	// the saves are written one by one, so an older config never replaces a newer one
+	permanentLobbiesSaveMutex = sync.Mutex{}
+)
+
+// preparePassword turns the plaintext password into the hash, it returns true if the definition is changed
+func (P *PermanentLobby) preparePassword() (bool, error) {
+	if P.Password != "" {
+		P.password = hashLobbyPassword(&P.Password)
+		P.Password = ""
+		P.PasswordHash = hex.EncodeToString(P.password.salt[:]) + hex.EncodeToString(P.password.hash[:])
+		return true, nil
+	}
+	if P.PasswordHash == "" {
+		P.password = nil
+		return false, nil
+	}
+	bts, err := hex.DecodeString(P.PasswordHash)
+	if err != nil || len(bts) != 16+32 {
+		return false, errors.New(fmt.Sprint("invalid passwordHash of the permanent lobby ", P.Key))
+	}
+	P.password = &lobbyPassword{}
+	copy(P.password.salt[:], bts[:16])
+	copy(P.password.hash[:], bts[16:])
+	return false, nil
+}
+
+// applyNoLock sets the lobby to the definition, the lobby must be empty and its lobbyMutex must be held
+func (L *LobbyData) applyPermanentNoLock(def *PermanentLobby) {
+	L.name = def.Name
+	L.enableP2P = def.EnableP2P
+	L.group = def.Group
+	L.data = map[string]string{}
+	for k, v := range def.Data {
+		L.data[k] = v
+	}
+	for i := range L.memberData {
+		L.memberData[i] = map[string]string{}
+	}
+	L.password = def.password
+	L.joinable = true
+	L.allowSpectators = true
+	L.visibility = def.Visibility
+	L.requireApproval = false
+	L.protocolVer, L.gameCrc = 0, 0
+	L.bans = make(map[SteamID]bool)
+	L.joinFailures = joinFailures{}
+}
+
+// open creates the lobby of the definition, permanentLobbiesMutex must not be held
+func (P *PermanentLobby) open() {
+	permanentLobbiesMutex.Lock()
+	def := *P
+	permanentLobbiesMutex.Unlock()
+
+	L := def.Instantiate(def.Group, 0, Isaacpb.RequestLogin_EN)
+	L.applyPermanentNoLock(&def)
+	L.permanent = P
+
+	lobbiesMutex.Lock()
+	lobbies[L.id] = L
+	lobbiesMutex.Unlock()
+	MarkLobbyDirty(L.id)
+
+	permanentLobbiesMutex.Lock()
+	P.lobby = L.id
+	permanentLobbiesMutex.Unlock()
+	log.Print("permanent lobby ", def.Key, " is opened as ", L.name, "(", L.id, "), group:", L.group)
+}
+
+// resetPermanent restores the empty lobby to its definition, it returns false if the lobby is not permanent
+func (L *LobbyData) resetPermanent() bool {
+	permanentLobbiesMutex.Lock()
+	P := L.permanent
+	var def PermanentLobby
+	if P != nil {
+		def = *P
+	}
+	permanentLobbiesMutex.Unlock()
+	if P == nil {
+		return false
+	}
+
+	L.RevokeInviteCode()
+	L.lobbyMutex.Lock()
+	spectators := append([]SteamID(nil), L.spectators...)
+	for _, u := range spectators {
+		L.RemoveSpectator(u)
+	}
+	resized := len(L.users) != LobbyCapacity(def.Capacity)
+	if !resized {
+		L.applyPermanentNoLock(&def)
+	}
+	L.lobbyMutex.Unlock()
+	for _, u := range spectators {
+		L.dropSpectator(u, Isaacpb.ResponseLobbyChatUpdate_Left)
+	}
+	MarkLobbyDirty(L.id)
+
+	if resized {
+		// the slots are allocated with the lobby, open a new one for the new size
+		lobbiesMutex.Lock()
+		delete(lobbies, L.id)
+		lobbiesMutex.Unlock()
+		MarkLobbyDirty(L.id)
+		permanentLobbiesMutex.Lock()
+		L.permanent = nil
+		permanentLobbiesMutex.Unlock()
+		P.open()
+		return true
+	}
+	log.Print("permanent lobby ", def.Key, "(", L.id, ") is empty, so reset it.")
+	return true
+}
+
+func (L *LobbyData) isPermanent() bool {
+	permanentLobbiesMutex.Lock()
+	defer permanentLobbiesMutex.Unlock()
+	return L.permanent != nil
+}
+
+// LoadPermanentLobbies reads the definitions from the data directory and opens the lobbies
+func LoadPermanentLobbies() error {
+	content, err := readDataFile(permanentLobbiesFile)
+	if err != nil || content == nil {
+		return err
+	}
+	var loaded []*PermanentLobby
+	if err := json.Unmarshal(content, &loaded); err != nil {
+		return errors.New(fmt.Sprint("failed to parse ", permanentLobbiesFile, ": ", err))
+	}
+	keys := map[string]bool{}
+	changed := false
+	for _, P := range loaded {
+		if P.Key == "" || keys[P.Key] {
+			return errors.New(fmt.Sprint("the permanent lobby key '", P.Key, "' is empty or used twice"))
+		}
+		keys[P.Key] = true
+		hashed, err := P.preparePassword()
+		if err != nil {
+			return err
+		}
+		changed = changed || hashed
+	}
+
+	permanentLobbiesMutex.Lock()
+	permanentLobbies = loaded
+	permanentLobbiesMutex.Unlock()
+	for _, P := range loaded {
+		P.open()
+	}
+	if changed {
+		// don't keep the plaintext passwords on the disk
+		savePermanentLobbies()
+	}
+	return nil
+}
+
+func savePermanentLobbies() {
+	permanentLobbiesSaveMutex.Lock()
+	defer permanentLobbiesSaveMutex.Unlock()
+	if dataFile(permanentLobbiesFile) == "" {
+		return
+	}
+
+	permanentLobbiesMutex.Lock()
+	content, err := json.MarshalIndent(permanentLobbies, "", "  ")
+	permanentLobbiesMutex.Unlock()
+	if err == nil {
+		err = writeDataFile(permanentLobbiesFile, content)
+	}
+	if err != nil {
+		log.Print("failed to save the permanent lobbies: ", err)
+	}
+}
+
+// the definitions are only useful if they are opened again at startup
+func checkPermanentLobbiesSavable() error {
+	if dataFile(permanentLobbiesFile) == "" {
+		return errors.New("no data directory is set (-d), the permanent lobbies can't be saved")
+	}
+	return nil
+}
+
+func findPermanentLobbyNoLock(key string) *PermanentLobby {
+	for _, P := range permanentLobbies {
+		if P.Key == key {
+			return P
+		}
+	}
+	return nil
+}
+
+func AddPermanentLobby(key string, group int32, capacity int, name string) error {
+	if err := checkPermanentLobbiesSavable(); err != nil {
+		return err
+	}
+	P := &PermanentLobby{
+		Key:           key,
+		LobbyTemplate: LobbyTemplate{Name: name, Capacity: capacity, EnableP2P: true, Group: group},
+	}
+	permanentLobbiesMutex.Lock()
+	if findPermanentLobbyNoLock(key) != nil {
+		permanentLobbiesMutex.Unlock()
+		return errors.New("the key is used by another permanent lobby")
+	}
+	permanentLobbies = append(permanentLobbies, P)
+	permanentLobbiesMutex.Unlock()
+
+	P.open()
+	savePermanentLobbies()
+	return nil
+}
+
+// RemovePermanentLobby keeps the lobby open as a normal one, it is deleted when it is empty
+func RemovePermanentLobby(key string) error {
+	if err := checkPermanentLobbiesSavable(); err != nil {
+		return err
+	}
+	permanentLobbiesMutex.Lock()
+	P := findPermanentLobbyNoLock(key)
+	if P == nil {
+		permanentLobbiesMutex.Unlock()
+		return errors.New("no such permanent lobby")
+	}
+	for i, other := range permanentLobbies {
+		if other == P {
+			permanentLobbies = append(permanentLobbies[:i:i], permanentLobbies[i+1:]...)
+			break
+		}
+	}
+	id := P.lobby
+	permanentLobbiesMutex.Unlock()
+
+	lobbiesMutex.Lock()
+	L, ok := lobbies[id]
+	lobbiesMutex.Unlock()
+	if ok {
+		permanentLobbiesMutex.Lock()
+		L.permanent = nil
+		permanentLobbiesMutex.Unlock()
+	}
+	savePermanentLobbies()
+	log.Print("permanent lobby ", key, " is removed")
+	return nil
+}
+
+// EditPermanentLobby changes the definition, the lobby follows it when it is empty
+func EditPermanentLobby(key string, edit func(P *PermanentLobby) error) error {
+	if err := checkPermanentLobbiesSavable(); err != nil {
+		return err
+	}
+	permanentLobbiesMutex.Lock()
+	P := findPermanentLobbyNoLock(key)
+	if P == nil {
+		permanentLobbiesMutex.Unlock()
+		return errors.New("no such permanent lobby")
+	}
+	def := *P
+	def.Data = map[string]string{}
+	for k, v := range P.Data {
+		def.Data[k] = v
+	}
+	err := edit(&def)
+	if err == nil {
+		_, err = def.preparePassword()
+	}
+	if err == nil {
+		def.lobby = P.lobby
+		*P = def
+	}
+	id := P.lobby
+	permanentLobbiesMutex.Unlock()
+	if err != nil {
+		return err
+	}
+	savePermanentLobbies()
+
+	lobbiesMutex.Lock()
+	L, ok := lobbies[id]
+	lobbiesMutex.Unlock()
+	if !ok {
+		return nil
+	}
+	L.lobbyMutex.Lock()
+	empty := L.UserCount() == 0
+	L.lobbyMutex.Unlock()
+	if empty {
+		L.resetPermanent()
+	}
+	return nil
+}
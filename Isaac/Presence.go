@@ -0,0 +1,237 @@
+/*
+	IsaacPaperServer server program
+	Copyright (C) 2024  frto027
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU Affero General Public License as
+	published by the Free Software Foundation, either version 3 of the
+	License, or (at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU Affero General Public License for more details.
+
+	You should have received a copy of the GNU Affero General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package Isaac
+
+import (
+	"IsaacPaperServer/0xf7.top/IsaacPaperServer/Isaacpb"
+	list2 "container/list"
+	"errors"
+	"log"
+	"sync"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+)
+
+// The presence is derived from the sessions and the lobbies, nothing is stored. The subscribers get
+// the changes of their friends by comparing with what they have been sent.
+
+var PresencePushInterval = time.Second
+
+type presenceSubscriber struct {
+	session *SessionData
+	last    map[SteamID]*Isaacpb.PlayerPresence
+	ready   bool // the snapshot is sent, the changes can be pushed
+}
+
+var (
+	presenceSubscribers      = map[SteamID]*presenceSubscriber{}
+	presenceSubscribersMutex = sync.Mutex{}
+)
+
+// playerPresence tells the viewer what the user is doing. The users who are not mutual friends of
+// the viewer or blocked the viewer look offline, and the lobbies hidden from the viewer are not named.
+func playerPresence(user SteamID, viewer SteamID) *Isaacpb.PlayerPresence {
+	p := Isaacpb.PlayerPresence{SteamId: uint64(user)}
+	sessionsMutex.Lock()
+	session, online := sessions[user]
+	sessionsMutex.Unlock()
+	if !online || !IsMutualFriend(user, viewer) || IsBlocked(user, viewer) {
+		relationsMutex.Lock()
+		if r, ok := relations[viewer]; ok {
+			p.Name = r.Friends[user]
+		}
+		relationsMutex.Unlock()
+		return &p
+	}
+	p.Name = session.name
+	p.State = Isaacpb.PresenceState_Browsing
+
+	current, spectating := session.LobbyState()
+	lobbiesMutex.Lock()
+	L, ok := lobbies[current]
+	lobbiesMutex.Unlock()
+	if !ok {
+		return &p
+	}
+	p.State = Isaacpb.PresenceState_InLobby
+	p.Spectating = spectating
+
+	info := L.ToProtobufLobbyInfo()
+	L.lobbyMutex.Lock()
+	// the relay address is registered once the game starts
+	if p.Spectating {
+		if pos := L.SpectatorPosition(user); pos != -1 && L.spectatorAddresses[pos].IsValid() {
+			p.State = Isaacpb.PresenceState_InGame
+		}
+	} else if pos := L.UserPosition(user); pos != -1 && L.udpAddresses[pos].IsValid() {
+		p.State = Isaacpb.PresenceState_InGame
+	}
+	free := L.freeSlotsNoLock(time.Now())
+	L.lobbyMutex.Unlock()
+
+	if lobbyVisibleTo(info, viewer) {
+		p.LobbyId = uint64(L.id)
+		p.LobbyName = info.Name
+		p.Joinable = !info.NotJoinable && free > 0
+	}
+	return &p
+}
+
+func (s *SessionData) presenceTargets(steamIds []uint64) []SteamID {
+	MaxRelationsMutex.Lock()
+	maxRelations := MaxRelations
+	MaxRelationsMutex.Unlock()
+
+	var targets []SteamID
+	if len(steamIds) == 0 {
+		relationsMutex.Lock()
+		if r, ok := relations[s.steamId]; ok {
+			for user := range r.Friends {
+				targets = append(targets, user)
+			}
+		}
+		relationsMutex.Unlock()
+	}
+	for _, id := range steamIds {
+		targets = append(targets, SteamID(id))
+	}
+	if len(targets) > maxRelations {
+		targets = targets[:maxRelations]
+	}
+	return targets
+}
+
+func (s *SessionData) QueryPresence(msg *Isaacpb.RequestPresenceQuery, holdValue int32) bool {
+	resp := Isaacpb.ResponsePresence{}
+	for _, user := range s.presenceTargets(msg.SteamIds) {
+		resp.Players = append(resp.Players, playerPresence(user, s.steamId))
+	}
+	return s.SendPackage(Isaacpb.ResponseHeader_Presence, holdValue, &resp)
+}
+
+// SubscribePresence sends the presence of the friends, then their changes will be pushed to the session
+func (s *SessionData) SubscribePresence(subscribe bool, holdValue int32) bool {
+	presenceSubscribersMutex.Lock()
+	if !subscribe {
+		// the user may have logged in again with a new session
+		if sub, ok := presenceSubscribers[s.steamId]; ok && sub.session == s {
+			delete(presenceSubscribers, s.steamId)
+		}
+		presenceSubscribersMutex.Unlock()
+		return true
+	}
+
+	// the push loop skips the subscriber until the snapshot is sent, then it pushes what changes after it
+	sub := presenceSubscriber{session: s, last: map[SteamID]*Isaacpb.PlayerPresence{}}
+	resp := Isaacpb.ResponsePresence{}
+	for _, user := range s.presenceTargets(nil) {
+		p := playerPresence(user, s.steamId)
+		sub.last[user] = p
+		resp.Players = append(resp.Players, p)
+	}
+	presenceSubscribers[s.steamId] = &sub
+	presenceSubscribersMutex.Unlock()
+	if !s.SendPackage(Isaacpb.ResponseHeader_Presence, holdValue, &resp) {
+		return false
+	}
+	presenceSubscribersMutex.Lock()
+	sub.ready = true
+	presenceSubscribersMutex.Unlock()
+	return true
+}
+
+func pushPresenceUpdates() {
+	// only the push loop changes sub.last after the snapshot is sent, so the presences are
+	// computed without the lock, they take the other global locks
+	var ready []*presenceSubscriber
+	presenceSubscribersMutex.Lock()
+	for _, sub := range presenceSubscribers {
+		if sub.ready {
+			ready = append(ready, sub)
+		}
+	}
+	presenceSubscribersMutex.Unlock()
+
+	type push struct {
+		session *SessionData
+		pkg     *Isaacpb.ResponsePresenceUpdate
+	}
+	pushes := list2.New()
+	for _, sub := range ready {
+		pkg := Isaacpb.ResponsePresenceUpdate{}
+		current := map[SteamID]*Isaacpb.PlayerPresence{}
+		for _, user := range sub.session.presenceTargets(nil) {
+			p := playerPresence(user, sub.session.steamId)
+			current[user] = p
+			if old, ok := sub.last[user]; !ok || !proto.Equal(old, p) {
+				pkg.Players = append(pkg.Players, p)
+			}
+		}
+		sub.last = current
+		if len(pkg.Players) > 0 {
+			pushes.PushBack(push{session: sub.session, pkg: &pkg})
+		}
+	}
+
+	for it := pushes.Front(); it != nil; it = it.Next() {
+		p := it.Value.(push)
+		p.session.SendPackage(Isaacpb.ResponseHeader_PresenceUpdate, 0, p.pkg)
+	}
+}
+
+func PresencePushLoop() {
+	for {
+		time.Sleep(PresencePushInterval)
+		pushPresenceUpdates()
+	}
+}
+
+// JoinPlayer joins the lobby the friend is in, with the same checks as joining it from the lobby list
+func (s *SessionData) JoinPlayer(msg *Isaacpb.RequestJoinPlayer, holdValue int32) error {
+	target := SteamID(msg.SteamId)
+	reason := ""
+	p := playerPresence(target, s.steamId)
+	switch {
+	case !IsFriend(s.steamId, target):
+		reason = s.localized("The player is not on your friend list", "该玩家不在您的好友列表中")
+	case p.State == Isaacpb.PresenceState_Offline:
+		reason = s.localized("The player is offline", "该玩家不在线")
+	case p.State == Isaacpb.PresenceState_Browsing || p.LobbyId == 0:
+		reason = s.localized("The player is not in a lobby you can join", "该玩家不在您可以加入的房间中")
+	}
+	if len(reason) > 0 {
+		log.Print("user ", s.name, "(", s.steamId, ") can't join the lobby of ", target)
+		s.SendConsoleMessage(s.localized("Failed to join the lobby", "房间加入失败"), reason)
+		if !s.SendPackage(Isaacpb.ResponseHeader_LobbyJoin, holdValue, &Isaacpb.ResponseLobbyJoin{
+			ChatRoomEnterResponse: uint32(Isaacpb.ResponseLobbyJoin_DoesntExist),
+			ChatPermissions:       1,
+		}) {
+			return errors.New("failed to send lobby join package")
+		}
+		return nil
+	}
+
+	log.Print("user ", s.name, "(", s.steamId, ") joins player ", p.Name, "(", target, ")")
+	return s.handleJoinLobby(&Isaacpb.RequestJoinLobby{
+		LobbyID:  p.LobbyId,
+		Password: msg.Password,
+		Spectate: msg.Spectate,
+	}, holdValue, false)
+}
@@ -0,0 +1,381 @@
+/*
+	IsaacPaperServer server program
+	Copyright (C) 2024  frto027
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU Affero General Public License as
+	published by the Free Software Foundation, either version 3 of the
+	License, or (at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU Affero General Public License for more details.
+
+	You should have received a copy of the GNU Affero General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package Isaac
+
+import (
+	"IsaacPaperServer/0xf7.top/IsaacPaperServer/Isaacpb"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"google.golang.org/protobuf/proto"
+)
+
+var (
+	// the size limit of a friend list or a block list
+	MaxRelations      = 200
+	MaxRelationsMutex = sync.Mutex{}
+
+	// relations[user] are the lists of the user, a friend list is one-directional like a follow list
+	relations      = map[SteamID]*relationList{}
+	relationsMutex = sync.Mutex{}
+
+	// the saves are written one by one, so an older list never replaces a newer one
+	relationsSaveMutex = sync.Mutex{}
+)
+
+const relationsFile = "relations.json"
+
+// relationList maps the SteamIDs to their last known names
+type relationList struct {
+	Friends map[SteamID]string `json:"friends"`
+	Blocks  map[SteamID]string `json:"blocks"`
+}
+
+// LoadRelations reads the friend lists and the block lists from the data directory
+func LoadRelations() error {
+	content, err := readDataFile(relationsFile)
+	if err != nil || content == nil {
+		return err
+	}
+	loaded := map[SteamID]*relationList{}
+	if err := json.Unmarshal(content, &loaded); err != nil {
+		return errors.New(fmt.Sprint("failed to parse ", relationsFile, ": ", err))
+	}
+	for _, r := range loaded {
+		if r.Friends == nil {
+			r.Friends = map[SteamID]string{}
+		}
+		if r.Blocks == nil {
+			r.Blocks = map[SteamID]string{}
+		}
+	}
+	relationsMutex.Lock()
+	relations = loaded
+	relationsMutex.Unlock()
+	log.Print("load the relations of ", len(loaded), " users from ", dataFile(relationsFile))
+	return nil
+}
+
+// saveRelations writes all lists into the data directory
+func saveRelations() {
+	relationsSaveMutex.Lock()
+	defer relationsSaveMutex.Unlock()
+	if dataFile(relationsFile) == "" {
+		return
+	}
+
+	relationsMutex.Lock()
+	content, err := json.Marshal(relations)
+	relationsMutex.Unlock()
+	if err == nil {
+		err = writeDataFile(relationsFile, content)
+	}
+	if err != nil {
+		log.Print("failed to save the relations: ", err)
+	}
+}
+
+func relationsOfNoLock(user SteamID) *relationList {
+	r, ok := relations[user]
+	if !ok {
+		r = &relationList{Friends: map[SteamID]string{}, Blocks: map[SteamID]string{}}
+		relations[user] = r
+	}
+	return r
+}
+
+// IsFriend tells if other is on the friend list of user
+func IsFriend(user SteamID, other SteamID) bool {
+	relationsMutex.Lock()
+	defer relationsMutex.Unlock()
+	r, ok := relations[user]
+	if !ok {
+		return false
+	}
+	_, friend := r.Friends[other]
+	return friend
+}
+
+// IsMutualFriend tells if the users are on the friend lists of each other, only they can see
+// whether the other is online
+func IsMutualFriend(user SteamID, other SteamID) bool {
+	return IsFriend(user, other) && IsFriend(other, user)
+}
+
+// IsBlocked tells if other is on the block list of user
+func IsBlocked(user SteamID, other SteamID) bool {
+	relationsMutex.Lock()
+	defer relationsMutex.Unlock()
+	r, ok := relations[user]
+	if !ok {
+		return false
+	}
+	_, blocked := r.Blocks[other]
+	return blocked
+}
+
+func isOnline(user SteamID) (string, bool) {
+	sessionsMutex.Lock()
+	defer sessionsMutex.Unlock()
+	session, ok := sessions[user]
+	if !ok {
+		return "", false
+	}
+	return session.name, true
+}
+
+// ChangeRelation adds or removes the target, it returns the reason if it fails. A user can't be
+// a friend and blocked at the same time, adding to one list removes the user from the other.
+func (s *SessionData) ChangeRelation(action Isaacpb.RequestRelation_Action, kind Isaacpb.RequestRelation_Kind, target SteamID) string {
+	if target == 0 || target == s.steamId {
+		return s.localized("Invalid player", "无效的玩家")
+	}
+	MaxRelationsMutex.Lock()
+	maxRelations := MaxRelations
+	MaxRelationsMutex.Unlock()
+	name, _ := isOnline(target)
+
+	relationsMutex.Lock()
+	r := relationsOfNoLock(s.steamId)
+	list, other := r.Friends, r.Blocks
+	if kind == Isaacpb.RequestRelation_Block {
+		list, other = r.Blocks, r.Friends
+	}
+	switch action {
+	case Isaacpb.RequestRelation_Add:
+		old, exists := list[target]
+		if !exists && len(list) >= maxRelations {
+			relationsMutex.Unlock()
+			return s.localized(fmt.Sprint("The list can hold at most ", maxRelations, " players"),
+				fmt.Sprint("列表中最多只能有", maxRelations, "个玩家"))
+		}
+		if name == "" {
+			name = old
+		}
+		if name == "" {
+			name = other[target]
+		}
+		list[target] = name
+		delete(other, target)
+	case Isaacpb.RequestRelation_Remove:
+		if _, exists := list[target]; !exists {
+			relationsMutex.Unlock()
+			return s.localized("The player is not on the list", "该玩家不在列表中")
+		}
+		delete(list, target)
+	default:
+		relationsMutex.Unlock()
+		return s.localized("Unknown action", "未知的操作")
+	}
+	relationsMutex.Unlock()
+
+	log.Print("user ", s.name, "(", s.steamId, ") ", action, " ", target, " on the ", kind, " list")
+	saveRelations()
+	markOwnedLobbiesDirty(s.steamId)
+	return ""
+}
+
+// markOwnedLobbiesDirty lets the lobby list subscribers check the friends-only lobbies of the owner again
+func markOwnedLobbiesDirty(owner SteamID) {
+	var owned []LobbyID
+	lobbiesMutex.Lock()
+	for id, L := range lobbies {
+		L.lobbyMutex.Lock()
+		if L.owner == owner {
+			owned = append(owned, id)
+		}
+		L.lobbyMutex.Unlock()
+	}
+	lobbiesMutex.Unlock()
+	for _, id := range owned {
+		MarkLobbyDirty(id)
+	}
+}
+
+func relationEntries(list map[SteamID]string) []*Isaacpb.RelationEntry {
+	entries := make([]*Isaacpb.RelationEntry, 0, len(list))
+	for user, name := range list {
+		entries = append(entries, &Isaacpb.RelationEntry{SteamId: uint64(user), Name: name})
+	}
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].SteamId < entries[j].SteamId
+	})
+	return entries
+}
+
+func (s *SessionData) relationList() *Isaacpb.ResponseRelationList {
+	relationsMutex.Lock()
+	resp := Isaacpb.ResponseRelationList{}
+	if r, ok := relations[s.steamId]; ok {
+		resp.Friends = relationEntries(r.Friends)
+		resp.Blocks = relationEntries(r.Blocks)
+	}
+	relationsMutex.Unlock()
+
+	for _, e := range resp.Friends {
+		if !IsMutualFriend(s.steamId, SteamID(e.SteamId)) {
+			continue
+		}
+		if name, online := isOnline(SteamID(e.SteamId)); online {
+			e.Name, e.Online = name, true
+		}
+	}
+	return &resp
+}
+
+func (s *SessionData) SendRelationList(holdValue int32) bool {
+	return s.SendPackage(Isaacpb.ResponseHeader_RelationList, holdValue, s.relationList())
+}
+
+// notifyFriendPresence tells the mutual friends of s that s comes online or goes offline, the users
+// blocked by s are not told. The last known names are updated as well.
+func (s *SessionData) notifyFriendPresence(online bool) {
+	var watchers []SteamID
+	relationsMutex.Lock()
+	renamed := false
+	for user, r := range relations {
+		if name, ok := r.Friends[s.steamId]; ok {
+			watchers = append(watchers, user)
+			if name != s.name {
+				r.Friends[s.steamId] = s.name
+				renamed = true
+			}
+		}
+		if name, ok := r.Blocks[s.steamId]; ok && name != s.name {
+			r.Blocks[s.steamId] = s.name
+			renamed = true
+		}
+	}
+	relationsMutex.Unlock()
+	if renamed {
+		saveRelations()
+	}
+
+	pkg := Isaacpb.ResponseFriendPresence{SteamId: uint64(s.steamId), Name: s.name, Online: online}
+	for _, user := range watchers {
+		if !IsFriend(s.steamId, user) || IsBlocked(s.steamId, user) {
+			continue
+		}
+		sessionsMutex.Lock()
+		session, ok := sessions[user]
+		sessionsMutex.Unlock()
+		if ok {
+			session.SendPackage(Isaacpb.ResponseHeader_FriendPresence, 0, &pkg)
+		}
+	}
+}
+
+// SendChatToAllUsers is SendPackageToAllUsers for the chat of from, the members who blocked from don't receive it
+func (L *LobbyData) SendChatToAllUsers(from SteamID, messageType Isaacpb.ResponseHeader_ResponseMessageType, m proto.Message) {
+	for _, userSteamId := range L.recipients() {
+		if userSteamId == 0 || IsBlocked(userSteamId, from) {
+			continue
+		}
+		sessionsMutex.Lock()
+		session, ok := sessions[userSteamId]
+		sessionsMutex.Unlock()
+		if !ok {
+			continue
+		}
+		session.SendPackage(messageType, 0, m)
+	}
+}
+
+func RelationCount() (int, int) {
+	relationsMutex.Lock()
+	defer relationsMutex.Unlock()
+	friends, blocks := 0, 0
+	for _, r := range relations {
+		friends += len(r.Friends)
+		blocks += len(r.Blocks)
+	}
+	return friends, blocks
+}
+
+func (s *SessionData) relationCommand(kind Isaacpb.RequestRelation_Kind, args string) string {
+	fields := strings.Fields(args)
+	if len(fields) == 0 {
+		resp := s.relationList()
+		entries := resp.Friends
+		if kind == Isaacpb.RequestRelation_Block {
+			entries = resp.Blocks
+		}
+		if len(entries) == 0 {
+			return s.localized("The list is empty", "列表为空")
+		}
+		sb := strings.Builder{}
+		for _, e := range entries {
+			sb.WriteString(fmt.Sprint(e.Name, "(", e.SteamId, ")"))
+			if kind == Isaacpb.RequestRelation_Friend && e.Online {
+				sb.WriteString(s.localized(" online", " 在线"))
+			} else if kind == Isaacpb.RequestRelation_Friend {
+				sb.WriteString(s.localized(" offline", " 离线"))
+			}
+			sb.WriteString("\n")
+		}
+		return sb.String()
+	}
+
+	usage := s.localized("usage: /"+strings.ToLower(kind.String())+" [add|remove <steamid>]",
+		"用法：/"+strings.ToLower(kind.String())+" [add|remove <steamid>]")
+	if len(fields) != 2 {
+		return usage
+	}
+	action := Isaacpb.RequestRelation_Add
+	switch fields[0] {
+	case "add":
+	case "remove":
+		action = Isaacpb.RequestRelation_Remove
+	default:
+		return usage
+	}
+	target, err := strconv.ParseUint(fields[1], 10, 64)
+	if err != nil {
+		return usage
+	}
+	if reason := s.ChangeRelation(action, kind, SteamID(target)); len(reason) > 0 {
+		return reason
+	}
+	return s.localized("Done", "完成")
+}
+
+func init() {
+	RegisterChatCommand(&simpleChatCommand{
+		name:   "friend",
+		helpEn: "/friend [add|remove <steamid>]  list or change your friend list",
+		helpZh: "/friend [add|remove <steamid>]  查看或修改好友列表",
+		run: func(s *SessionData, args string) string {
+			return s.relationCommand(Isaacpb.RequestRelation_Friend, args)
+		},
+	})
+	RegisterChatCommand(&simpleChatCommand{
+		name:   "block",
+		helpEn: "/block [add|remove <steamid>]  list or change your block list",
+		helpZh: "/block [add|remove <steamid>]  查看或修改屏蔽列表",
+		run: func(s *SessionData, args string) string {
+			return s.relationCommand(Isaacpb.RequestRelation_Block, args)
+		},
+	})
+}
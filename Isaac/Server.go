@@ -20,14 +20,12 @@ package Isaac
 
 import (
 	"IsaacPaperServer/0xf7.top/IsaacPaperServer/Isaacpb"
-	list2 "container/list"
 	"encoding/binary"
 	"log"
 	"net"
 	"regexp"
 	"strings"
 	"sync"
-	"time"
 	"unicode/utf8"
 
 	"google.golang.org/protobuf/proto"
@@ -106,7 +104,14 @@ func ServeTcp(conn net.Conn) {
 			return
 		}
 
+		if header.Length < 0 || int(header.Length) > maxRequestSize() {
+			log.Print("request size ", header.Length, " is too big!")
+			return
+		}
 		size = uint32(header.Length)
+		if int(size) > len(buff) {
+			buff = make([]byte, size)
+		}
 		readed = 0
 		for readed < size {
 			r, err := conn.Read(buff[readed:size])
@@ -120,7 +125,7 @@ func ServeTcp(conn net.Conn) {
 			}
 			readed += uint32(r)
 		}
-		if err := session.HandlePackage(&header, buff[0:size]); err != nil {
+		if err := session.HandleRequest(&header, buff[0:size]); err != nil {
 			log.Print("session closed: ", err)
 			return
 		}
@@ -140,21 +145,3 @@ func ServeForever(network string, addr string) {
 		go ServeTcp(conn)
 	}
 }
-
-func DeleteOldLobbies() int {
-	deleteIfBefore := time.Now().Add(-time.Minute)
-	count := 0
-	toDel := list2.New()
-	lobbiesMutex.Lock()
-	for ID, L := range lobbies {
-		if L.UserCount() == 0 && L.createTime.Before(deleteIfBefore) {
-			toDel.PushBack(ID)
-			count++
-		}
-	}
-	for e := toDel.Front(); e != nil; e = e.Next() {
-		delete(lobbies, e.Value.(LobbyID))
-	}
-	lobbiesMutex.Unlock()
-	return count
-}
@@ -26,6 +26,7 @@ import (
 	"log"
 	"math/rand"
 	"net"
+	"strings"
 	"sync"
 	"time"
 
@@ -43,8 +44,28 @@ type SessionData struct {
 	currentLobby  LobbyID
 	connSendMutex sync.Mutex
 	langId        Isaacpb.RequestLogin_Lang
+	protocolVer   uint64
+	gameCrc       uint32
 
 	lastWaitToken string
+
+	chatTimes      []time.Time
+	lastGlobalChat time.Time
+
+	spectating bool // the user is a spectator of currentLobby
+
+	maxMessageSize int  // the biggest frame body the client can receive
+	fragments      bool // the client can join the fragments of a big response
+	negotiated     bool // the client told its limits at login, it knows the newer responses like RequestFailed
+
+	handleMutex sync.Mutex // held while a request or a task of the session is handled, see RunTask
+	stateMutex  sync.Mutex // guards currentLobby and spectating, see setLobbyState
+	tasks       []func()
+	tasksMutex  sync.Mutex
+
+	closing     chan struct{} // closed when the session is closed
+	mirrorQueue chan mirroredPackage
+	mirrorOnce  sync.Once
 }
 
 func (s *SessionData) Create(conn net.Conn) {
@@ -53,6 +74,8 @@ func (s *SessionData) Create(conn net.Conn) {
 	s.hasLogin = false
 	s.currentLobby = 0
 	s.connSendMutex = sync.Mutex{}
+	s.maxMessageSize = LegacyMessageSize
+	s.closing = make(chan struct{})
 }
 func (s *SessionData) IsAlive() bool {
 	if s.closed {
@@ -60,10 +83,30 @@ func (s *SessionData) IsAlive() bool {
 	}
 	return true
 }
+func (s *SessionData) SteamID() SteamID {
+	return s.steamId
+}
+func (s *SessionData) Name() string {
+	return s.name
+}
+func (s *SessionData) Lang() Isaacpb.RequestLogin_Lang {
+	return s.langId
+}
+func (s *SessionData) CurrentLobby() LobbyID {
+	return s.currentLobby
+}
+
+// recipients copies the members and the spectators under the lobby lock, the caller must not hold it
+func (L *LobbyData) recipients() []SteamID {
+	L.lobbyMutex.Lock()
+	defer L.lobbyMutex.Unlock()
+	return append(append([]SteamID(nil), L.users...), L.spectators...)
+}
 
+// SendPackageToAllUsers sends the package to all members, as well as the spectators
 func (L *LobbyData) SendPackageToAllUsers(messageType Isaacpb.ResponseHeader_ResponseMessageType, holdValue int32, m proto.Message, except SteamID) {
-	for _, userSteamId := range L.users {
-		if userSteamId == except {
+	for _, userSteamId := range L.recipients() {
+		if userSteamId == except || userSteamId == 0 {
 			continue
 		}
 		sessionsMutex.Lock()
@@ -77,7 +120,7 @@ func (L *LobbyData) SendPackageToAllUsers(messageType Isaacpb.ResponseHeader_Res
 }
 
 func (L *LobbyData) SendUserInfoToAllUsers() {
-	for _, userSteamId := range L.users {
+	for _, userSteamId := range L.recipients() {
 		if userSteamId == SteamID(0) {
 			continue
 		}
@@ -105,7 +148,7 @@ func (s *SessionData) SendUserInfo(id SteamID) {
 }
 
 func (s *SessionData) SendUserInfos(L *LobbyData) {
-	for _, u := range L.users {
+	for _, u := range L.recipients() {
 		s.SendUserInfo(u)
 	}
 }
@@ -117,12 +160,25 @@ func (s *SessionData) JoinLobby(id LobbyID) bool {
 	if !ok {
 		return false
 	}
+	L.lobbyMutex.Lock()
 	_, err := L.AddUser(s.steamId)
+	if err == nil {
+		L.joined = true
+	}
+	if err == nil && L.owner == 0 {
+		// the lobby is created by the server, the first member owns it
+		L.owner = s.steamId
+		L.protocolVer = s.protocolVer
+		L.gameCrc = s.gameCrc
+	}
+	L.lobbyMutex.Unlock()
 	if err != nil {
 		return false
 	}
+	MarkLobbyDirty(id)
+	L.touch()
 
-	s.currentLobby = id
+	s.setLobbyState(id, false)
 	return true
 }
 func (s *SessionData) LeaveLobby() {
@@ -134,16 +190,34 @@ func (s *SessionData) LeaveLobby() {
 	lobby, ok := lobbies[s.currentLobby]
 	lobbiesMutex.Unlock()
 
-	if ok {
+	if ok && s.spectating {
+		lobby.lobbyMutex.Lock()
+		lobby.RemoveSpectator(s.steamId)
+		lobby.lobbyMutex.Unlock()
+		MarkLobbyDirty(lobby.id)
+		lobby.notifySpectatorChanged(s.steamId, s.steamId, Isaacpb.ResponseLobbyChatUpdate_Left)
+		log.Print("user ", s.name, "(", s.steamId, ") stops spectating lobby ", lobby.id)
+	} else if ok {
 		lobby.lobbyMutex.Lock()
-		lobby.RemoveUser(s.steamId)
+		ownerChanged := lobby.RemoveUser(s.steamId)
+		empty := lobby.UserCount() == 0
+		spectators := lobby.spectators
 		lobby.lobbyMutex.Unlock()
+		MarkLobbyDirty(lobby.id)
 		//TODO: send leave user package to others
-		if lobby.UserCount() == 0 {
+		if empty && lobby.resetPermanent() {
+			// the admin keeps the lobby open
+		} else if empty {
 			lobbiesMutex.Lock()
 			delete(lobbies, s.currentLobby)
 			lobbiesMutex.Unlock()
+			// mark it again, the list may be refreshed between the first mark and the deletion
+			MarkLobbyDirty(lobby.id)
+			lobby.RevokeInviteCode()
 			log.Print("lobby ", lobby.id, " is empty, so remove it.")
+			for _, u := range spectators {
+				lobby.dropSpectator(u, Isaacpb.ResponseLobbyChatUpdate_Left)
+			}
 		} else {
 			lobby.SendPackageToAllUsers(Isaacpb.ResponseHeader_LobbyChatUpdate, 0, &Isaacpb.ResponseLobbyChatUpdate{
 				SteamIdLobby:               uint64(lobby.id),
@@ -153,14 +227,35 @@ func (s *SessionData) LeaveLobby() {
 				SteamIdUserChangedIsLobby:  false,
 				ChatMemberStateChange:      Isaacpb.ResponseLobbyChatUpdate_Left,
 			}, s.steamId)
+			if ownerChanged {
+				lobby.notifyOwnerChanged(s.steamId)
+			}
 		}
 	}
 
-	s.currentLobby = 0
+	s.setLobbyState(0, false)
+	s.removeUdpToken()
+}
+
+// setLobbyState changes currentLobby and spectating. Only the requests and the tasks of the session
+// change them, so they read the fields directly, the other goroutines use LobbyState.
+func (s *SessionData) setLobbyState(id LobbyID, spectating bool) {
+	s.stateMutex.Lock()
+	s.currentLobby, s.spectating = id, spectating
+	s.stateMutex.Unlock()
+}
 
+// LobbyState returns the lobby of the session and whether it is spectating
+func (s *SessionData) LobbyState() (LobbyID, bool) {
+	s.stateMutex.Lock()
+	defer s.stateMutex.Unlock()
+	return s.currentLobby, s.spectating
+}
+
+func (s *SessionData) removeUdpToken() {
 	if len(s.lastWaitToken) > 0 {
 		waitingClientsMutex.Lock()
-		if _, ok = waitingClients[s.lastWaitToken]; ok {
+		if _, ok := waitingClients[s.lastWaitToken]; ok {
 			delete(waitingClients, s.lastWaitToken)
 		}
 		waitingClientsMutex.Unlock()
@@ -186,16 +281,40 @@ func (s *SessionData) SendPackageNoLock(messageType Isaacpb.ResponseHeader_Respo
 		return false
 	}
 
+	if len(bts) > responseSizeLimit(messageType) {
+		s.sendTooLargeNoLock(messageType, holdValue, len(bts))
+		return false
+	}
+
 	header := Isaacpb.ResponseHeader{}
 	header.Type = messageType
 	header.HoldValue = holdValue
 	header.Length = int32(len(bts))
-	if len(bts) >= 4096 {
-		log.Print("Message is too long")
+	if len(bts) < s.maxMessageSize {
+		return s.sendFrameNoLock(&header, bts)
+	}
+	if !s.fragments {
+		s.sendTooLargeNoLock(messageType, holdValue, len(bts))
 		return false
 	}
 
-	hbts, err := proto.Marshal(&header)
+	// split the message, the fragments are sent together while the connSendMutex is held
+	fragmentSize := s.maxMessageSize - 1
+	header.FragmentCount = uint32((len(bts) + fragmentSize - 1) / fragmentSize)
+	header.TotalLength = uint32(len(bts))
+	for i := 0; i < int(header.FragmentCount); i++ {
+		fragment := bts[i*fragmentSize : min((i+1)*fragmentSize, len(bts))]
+		header.FragmentIndex = uint32(i)
+		header.Length = int32(len(fragment))
+		if !s.sendFrameNoLock(&header, fragment) {
+			return false
+		}
+	}
+	return true
+}
+
+func (s *SessionData) sendFrameNoLock(header *Isaacpb.ResponseHeader, bts []byte) bool {
+	hbts, err := proto.Marshal(header)
 	if err != nil {
 		log.Print(err)
 		return false
@@ -314,6 +433,8 @@ func (s *SessionData) HandlePackage(header *Isaacpb.RequestHeader, body []byte)
 
 		s.name = msg.Name
 		s.steamId = SteamID(msg.SteamID)
+		s.protocolVer = msg.ProtocolVer
+		s.gameCrc = msg.GetGameImageCrc()
 
 		s.hasLogin = true
 
@@ -329,9 +450,21 @@ func (s *SessionData) HandlePackage(header *Isaacpb.RequestHeader, body []byte)
 			return errors.New(fmt.Sprint("server protocol mismatch, client is ", msg.ProtocolVer))
 		}
 
+		if err := s.negotiateMessageSize(&msg); err != nil {
+			caption := "PaperCup message size mismatch!"
+			hint := fmt.Sprint("Your client can't receive messages larger than ", msg.MaxMessageSize, " bytes, the server needs at least ", MinMessageSize)
+			s.SendPackage(Isaacpb.ResponseHeader_ServerPublicMessage, 0, &Isaacpb.ResponseServerPublicMessage{
+				Type:    Isaacpb.ResponseServerPublicMessage_DisplayStringAndExit,
+				Caption: &caption,
+				Str:     &hint,
+			})
+			return err
+		}
+
 		sessionsMutex.Lock()
 		sessions[s.steamId] = s
 		sessionsMutex.Unlock()
+		s.notifyFriendPresence(true)
 
 		if true {
 			caption := "Welcome to Isaac Paper Phone"
@@ -348,13 +481,13 @@ func (s *SessionData) HandlePackage(header *Isaacpb.RequestHeader, body []byte)
 			})
 		}
 
-		DefaultLobbyNamesMutex.Lock()
-		if DefaultLobbyNames != nil {
-			s.SendPackage(Isaacpb.ResponseHeader_UpdateCreateRoomNameLists, 0, &Isaacpb.ResponseCreateRoomNameLists{
-				Names: *DefaultLobbyNames,
-			})
+		MessageOfTheDayMutex.Lock()
+		if MessageOfTheDay != nil {
+			s.SendConsoleMessage(s.localized("Message of the day", "今日消息"), *MessageOfTheDay)
 		}
-		DefaultLobbyNamesMutex.Unlock()
+		MessageOfTheDayMutex.Unlock()
+
+		s.SendCreateRoomNameLists()
 
 		DefaultFastChatMessagesMutex.Lock()
 		if DefaultFastChatMessages != nil {
@@ -373,21 +506,12 @@ func (s *SessionData) HandlePackage(header *Isaacpb.RequestHeader, body []byte)
 			return errors.New("failed to parse lobby list package")
 		}
 
-		log.Print("user ", s.name, " request lobby list")
+		log.Print("user ", s.name, " request lobby list of group ", msg.LobbyGroup)
 
-		r := Isaacpb.ResponseLobbyList{}
-
-		lobbiesMutex.Lock()
-		r.Lobbies = make([]*Isaacpb.LobbyInfo, len(lobbies))
-		idx := 0
-		for _, data := range lobbies {
-			r.Lobbies[idx] = data.ToProtobufLobbyInfo()
-			idx++
-		}
-		lobbiesMutex.Unlock()
+		r := BuildLobbyList(&msg, s.steamId)
 
 		if !s.SendPackage(Isaacpb.ResponseHeader_LobbyList, header.HoldValue,
-			&r) {
+			r) {
 			return errors.New("failed to send lobby list package")
 		}
 	case Isaacpb.RequestHeader_LobbyCreate:
@@ -397,19 +521,56 @@ func (s *SessionData) HandlePackage(header *Isaacpb.RequestHeader, body []byte)
 			return errors.New("failed to parse lobby create info package")
 		}
 
-		lobby := LobbyData{}
-		lobby.Create()
+		template := LobbyTemplate{
+			Name:       filterStr(msg.Name),
+			Capacity:   int(msg.MaxMembers),
+			EnableP2P:  msg.EnableP2P,
+			Group:      msg.LobbyGroup,
+			Visibility: msg.Visibility,
+		}
+		if len(msg.Template) > 0 {
+			T, ok := FindLobbyTemplate(msg.Template)
+			if !ok {
+				log.Print("user ", s.name, "(", s.steamId, ") wants to create a lobby with the unknown template ", msg.Template)
+				if !s.sendRequestFailed(Isaacpb.ResponseHeader_LobbyCreated, header.HoldValue, Isaacpb.ResponseRequestFailed_InvalidData, s.localized("The lobby template doesn't exist", "房间模板不存在")) {
+					return errors.New("failed to send request failed package")
+				}
+				return nil
+			}
+			// the template decides everything, the user can name the lobby only if the template doesn't number it
+			if len(template.Name) > 0 && !strings.Contains(T.Name, "{n}") {
+				T.Name = template.Name
+			}
+			template = T
+		}
+		if _, known := Isaacpb.LobbyVisibility_name[int32(template.Visibility)]; !known {
+			log.Print("user ", s.name, "(", s.steamId, ") wants to create a lobby with the unknown visibility ", template.Visibility)
+			if !s.sendRequestFailed(Isaacpb.ResponseHeader_LobbyCreated, header.HoldValue, Isaacpb.ResponseRequestFailed_InvalidData, s.localized("The lobby visibility is invalid", "房间可见性无效")) {
+				return errors.New("failed to send request failed package")
+			}
+			return nil
+		}
 
-		lobby.name = filterStr(msg.Name)
-		lobby.password = msg.Password
-		lobby.enableP2P = msg.EnableP2P
+		if reason, message := s.lobbyCreateRejection(); len(message) > 0 {
+			log.Print("user ", s.name, "(", s.steamId, ") can't create a lobby: ", reason)
+			if !s.sendRequestFailed(Isaacpb.ResponseHeader_LobbyCreated, header.HoldValue, reason, message) {
+				return errors.New("failed to send request failed package")
+			}
+			return nil
+		}
+
+		lobby := template.Instantiate(template.Group, 0, s.langId)
+		lobby.password = hashLobbyPassword(msg.Password)
+		lobby.requireApproval = msg.RequireApproval
+		lobby.protocolVer = s.protocolVer
+		lobby.gameCrc = s.gameCrc
 
 		_, _ = lobby.AddUser(s.steamId)
 		lobby.owner = s.steamId
 		info := lobby.ToProtobufLobbyInfo()
-		if lobby.password != nil {
+		if msg.Password != nil {
 			//the user that create the lobby knows the password
-			info.Password = lobby.password
+			info.Password = msg.Password
 		}
 		if !s.SendPackage(Isaacpb.ResponseHeader_LobbyCreated, header.HoldValue,
 			&Isaacpb.ResponseLobbyCreated{
@@ -423,10 +584,11 @@ func (s *SessionData) HandlePackage(header *Isaacpb.RequestHeader, body []byte)
 		if lobby.password == nil {
 			isLocked = ""
 		}
-		log.Print(isLocked, "lobby ", lobby.name, "(", lobby.id, ") is created by steam user ", s.name, "(", s.steamId, ")", " p2p:", lobby.enableP2P)
+		log.Print(isLocked, "lobby ", lobby.name, "(", lobby.id, ") is created by steam user ", s.name, "(", s.steamId, ")", " p2p:", lobby.enableP2P, " group:", lobby.group)
 		lobbiesMutex.Lock()
-		lobbies[lobby.id] = &lobby
+		lobbies[lobby.id] = lobby
 		lobbiesMutex.Unlock()
+		MarkLobbyDirty(lobby.id)
 
 		if !s.SendLobbyDataUpdate(s.steamId, lobby.id, true) {
 			return errors.New("failed to send lobby data update package")
@@ -449,8 +611,21 @@ func (s *SessionData) HandlePackage(header *Isaacpb.RequestHeader, body []byte)
 			lobbiesMutex.Unlock()
 			return errors.New(fmt.Sprint("SetLobbyData: user is not in the lobby"))
 		}
+		maxLobbyKeys, _ := lobbyDataKeyLimits()
+		L.lobbyMutex.Lock()
+		if e := checkDataWrite(L.data, msg.PchKey, msg.PchValue, maxLobbyKeys, L.owner == s.steamId); e != nil {
+			L.lobbyMutex.Unlock()
+			lobbiesMutex.Unlock()
+			if !s.sendDataWriteFailed(Isaacpb.ResponseHeader_LobbyDataUpdate, header.HoldValue, msg.PchKey, e) {
+				return errors.New("failed to send request failed package")
+			}
+			return nil
+		}
 		L.data[msg.PchKey] = msg.PchValue
+		L.lobbyMutex.Unlock()
+		L.touch()
 		lobbiesMutex.Unlock()
+		MarkLobbyDirty(L.id)
 
 		//FIXME: lock?
 		L.SendPackageToAllUsers(Isaacpb.ResponseHeader_LobbyDataUpdate, 0, &Isaacpb.ResponseLobbyDataUpdate{
@@ -478,7 +653,18 @@ func (s *SessionData) HandlePackage(header *Isaacpb.RequestHeader, body []byte)
 			log.Print("invalid user")
 			return errors.New("invalid user")
 		}
+		_, maxMemberKeys := lobbyDataKeyLimits()
+		L.lobbyMutex.Lock()
+		if e := checkDataWrite(L.memberData[pos], msg.PchKey, msg.PchValue, maxMemberKeys, L.owner == s.steamId); e != nil {
+			L.lobbyMutex.Unlock()
+			if !s.sendDataWriteFailed(Isaacpb.ResponseHeader_LobbyMemberDataUpdate, header.HoldValue, msg.PchKey, e) {
+				return errors.New("failed to send request failed package")
+			}
+			return nil
+		}
 		L.memberData[pos][msg.PchKey] = msg.PchValue
+		L.lobbyMutex.Unlock()
+		L.touch()
 		//FIXME: lock?
 		L.SendPackageToAllUsers(Isaacpb.ResponseHeader_LobbyMemberDataUpdate, 0, &Isaacpb.ResponseLobbyDataUpdate{
 			SteamIdLobby:  msg.LobbyID,
@@ -492,59 +678,7 @@ func (s *SessionData) HandlePackage(header *Isaacpb.RequestHeader, body []byte)
 			log.Print(err)
 			return errors.New("failed to parse JoinLobby package")
 		}
-		log.Print("user ", s.name, "(", s.steamId, ") wants join lobby ", msg.LobbyID)
-		if s.currentLobby != LobbyID(0) {
-			log.Print("the user is already in lobby ", s.currentLobby, ", we will let the user leave")
-			return errors.New("user already in lobby")
-		}
-
-		resp := Isaacpb.ResponseLobbyJoin{}
-
-		lobbiesMutex.Lock()
-		lobby, ok := lobbies[LobbyID(msg.LobbyID)]
-		lobbiesMutex.Unlock()
-
-		if ok &&
-			(lobby.password == nil || (msg.Password != nil && *(msg.Password) == *(lobby.password))) &&
-			s.JoinLobby(LobbyID(msg.LobbyID)) {
-
-			resp.LobbyId = uint32(msg.LobbyID)
-			resp.Locked = false
-			resp.ChatRoomEnterResponse = uint32(Isaacpb.ResponseLobbyJoin_Success)
-			resp.ChatPermissions = 1
-			resp.Info = lobby.ToProtobufLobbyInfoWithUserData()
-		} else {
-			resp.LobbyId = uint32(msg.LobbyID)
-			resp.Locked = false
-			resp.ChatRoomEnterResponse = uint32(Isaacpb.ResponseLobbyJoin_NotAllowed)
-			resp.ChatPermissions = 1
-			if ok && lobby.password != nil && (msg.Password == nil || *(msg.Password) != *(lobby.password)) {
-				str := "密码错误"
-				c := "房间加入失败"
-				s.SendPackage(Isaacpb.ResponseHeader_ServerPublicMessage, 0, &Isaacpb.ResponseServerPublicMessage{
-					Type:    Isaacpb.ResponseServerPublicMessage_DisplayStringAtLogConsole,
-					Str:     &str,
-					Caption: &c,
-				})
-			}
-		}
-		lobby.SendUserInfoToAllUsers()
-		s.SendPackage(Isaacpb.ResponseHeader_LobbyJoin, header.HoldValue, &resp)
-		lobby.SendPackageToAllUsers(Isaacpb.ResponseHeader_LobbyChatUpdate, 0, &Isaacpb.ResponseLobbyChatUpdate{
-			SteamIdLobby:               uint64(lobby.id),
-			SteamIdMakingChange:        0,
-			SteamIdUserChanged:         uint64(s.steamId),
-			SteamIdMakingChangeIsLobby: false,
-			SteamIdUserChangedIsLobby:  false,
-			ChatMemberStateChange:      Isaacpb.ResponseLobbyChatUpdate_Entered,
-			LobbyInfo:                  lobby.ToProtobufLobbyInfoWithUserData(),
-		}, s.steamId)
-		/*
-			lobby.SendPackageToAllUsers(Isaacpb.ResponseHeader_LobbyDataUpdate, 0, &Isaacpb.ResponseLobbyDataUpdate{
-				SteamIdLobby:  uint64(lobby.id),
-				SteamIdMember: uint64(s.steamId),
-				OnlyLobbyId:   false,
-			}, s.steamId)*/
+		return s.handleJoinLobby(&msg, header.HoldValue, false)
 	case Isaacpb.RequestHeader_SendP2PPackage:
 		msg := Isaacpb.RequestSendP2PPackage{}
 		if err := proto.Unmarshal(body, &msg); err != nil {
@@ -560,6 +694,10 @@ func (s *SessionData) HandlePackage(header *Isaacpb.RequestHeader, body []byte)
 		if !hasOtherSession {
 			log.Print("unknown target ", msg.SteamIDRemote)
 		}
+		if s.spectating {
+			// spectators can't send anything into the game, read the package and drop it
+			hasOtherSession = false
+		}
 
 		read := uint32(0)
 		blockSize := msg.FollowingDataSize
@@ -646,6 +784,23 @@ func (s *SessionData) HandlePackage(header *Isaacpb.RequestHeader, body []byte)
 		} else {
 			//log.Print("a package was not sent")
 		}
+
+		if !s.spectating && s.currentLobby != 0 {
+			lobbiesMutex.Lock()
+			L, ok := lobbies[s.currentLobby]
+			lobbiesMutex.Unlock()
+			if ok {
+				// the game traffic of the lobby goes through the server, so it is never idle while playing
+				L.lobbyMutex.Lock()
+				L.relayed = true
+				L.lobbyMutex.Unlock()
+				L.touch()
+			}
+			// only the packages in a single block are mirrored, the buffer doesn't hold the bigger ones
+			if ok && msg.FollowingDataSize <= blockSize {
+				L.mirrorP2PPackage(s.steamId, msg.Channel, buffer[:msg.FollowingDataSize])
+			}
+		}
 	case Isaacpb.RequestHeader_LobbyLeave:
 		msg := Isaacpb.RequestLeaveLobby{}
 		if err := proto.Unmarshal(body, &msg); err != nil {
@@ -674,9 +829,10 @@ func (s *SessionData) HandlePackage(header *Isaacpb.RequestHeader, body []byte)
 
 		L.lobbyMutex.Lock()
 		pos := L.UserPosition(s.steamId)
+		isSpectator := L.HasSpectator(s.steamId)
 		L.lobbyMutex.Unlock()
 
-		if pos == -1 {
+		if pos == -1 && !isSpectator {
 			s.SendPackage(Isaacpb.ResponseHeader_ServerUdpToken, 0, &Isaacpb.ResponseServerUdpToken{Token: ""})
 			return nil
 		}
@@ -688,6 +844,7 @@ func (s *SessionData) HandlePackage(header *Isaacpb.RequestHeader, body []byte)
 		waitingClients[nextToken] = UDPWaitingClientItem{
 			lobby:    s.currentLobby,
 			position: pos,
+			user:     s.steamId,
 		}
 		waitingClientsMutex.Unlock()
 
@@ -700,31 +857,319 @@ func (s *SessionData) HandlePackage(header *Isaacpb.RequestHeader, body []byte)
 			return errors.New("failed to parse LogConsoleChat package")
 		}
 
+		if strings.HasPrefix(msg.Message, "/") {
+			s.HandleChatCommand(msg.Message)
+			return nil
+		}
+
 		lobbiesMutex.Lock()
 		L, ok := lobbies[s.currentLobby]
 		lobbiesMutex.Unlock()
 		if ok {
-			filteredStr := filterStr(msg.Message)
+			filteredStr, ok := s.prepareChat(msg.Message)
+			if !ok {
+				return nil
+			}
 
 			log.Print("user ", s.name, "(", s.steamId, ") say:", filteredStr, "(", msg.Message, ")")
 
-			L.lobbyMutex.Lock()
-			L.SendPackageToAllUsers(Isaacpb.ResponseHeader_LogConsoleChat, 0, &Isaacpb.ResponseLogConsoleChat{
+			L.SendChatToAllUsers(s.steamId, Isaacpb.ResponseHeader_LogConsoleChat, &Isaacpb.ResponseLogConsoleChat{
 				Steamid: int64(s.steamId),
 				Message: filteredStr,
-			}, 0)
-			L.lobbyMutex.Unlock()
+			})
+			L.touch()
+		}
+	case Isaacpb.RequestHeader_GlobalChatJoin:
+		msg := Isaacpb.RequestGlobalChatJoin{}
+		if err := proto.Unmarshal(body, &msg); err != nil {
+			log.Print(err)
+			return errors.New("failed to parse GlobalChatJoin package")
+		}
+
+		s.JoinGlobalChat(msg.Join)
+		if !s.SendGlobalChatStatus() {
+			return errors.New("failed to send global chat status package")
+		}
+	case Isaacpb.RequestHeader_GlobalChat:
+		msg := Isaacpb.RequestGlobalChat{}
+		if err := proto.Unmarshal(body, &msg); err != nil {
+			log.Print(err)
+			return errors.New("failed to parse GlobalChat package")
 		}
+
+		s.SayGlobal(msg.Message)
+	case Isaacpb.RequestHeader_LobbyKick:
+		msg := Isaacpb.RequestLobbyKick{}
+		if err := proto.Unmarshal(body, &msg); err != nil {
+			log.Print(err)
+			return errors.New("failed to parse LobbyKick package")
+		}
+
+		if reason := s.KickLobbyMember(LobbyID(msg.LobbyID), SteamID(msg.SteamIdTarget)); len(reason) > 0 {
+			s.SendConsoleMessage(s.localized("Lobby", "房间"), reason)
+		}
+	case Isaacpb.RequestHeader_LobbyBan:
+		msg := Isaacpb.RequestLobbyBan{}
+		if err := proto.Unmarshal(body, &msg); err != nil {
+			log.Print(err)
+			return errors.New("failed to parse LobbyBan package")
+		}
+
+		if reason := s.BanLobbyMember(LobbyID(msg.LobbyID), SteamID(msg.SteamIdTarget), msg.Unban); len(reason) > 0 {
+			s.SendConsoleMessage(s.localized("Lobby", "房间"), reason)
+		}
+	case Isaacpb.RequestHeader_LobbySetPassword:
+		msg := Isaacpb.RequestLobbySetPassword{}
+		if err := proto.Unmarshal(body, &msg); err != nil {
+			log.Print(err)
+			return errors.New("failed to parse LobbySetPassword package")
+		}
+
+		if reason := s.SetLobbyPassword(LobbyID(msg.LobbyID), msg.Password); len(reason) > 0 {
+			s.SendConsoleMessage(s.localized("Lobby", "房间"), reason)
+		}
+	case Isaacpb.RequestHeader_LobbyTransferOwner:
+		msg := Isaacpb.RequestLobbyTransferOwner{}
+		if err := proto.Unmarshal(body, &msg); err != nil {
+			log.Print(err)
+			return errors.New("failed to parse LobbyTransferOwner package")
+		}
+
+		if reason := s.TransferLobbyOwner(LobbyID(msg.LobbyID), SteamID(msg.SteamIdNewOwner)); len(reason) > 0 {
+			s.SendConsoleMessage(s.localized("Lobby", "房间"), reason)
+		}
+	case Isaacpb.RequestHeader_LobbySetSpectators:
+		msg := Isaacpb.RequestLobbySetSpectators{}
+		if err := proto.Unmarshal(body, &msg); err != nil {
+			log.Print(err)
+			return errors.New("failed to parse LobbySetSpectators package")
+		}
+
+		if reason := s.SetLobbySpectators(LobbyID(msg.LobbyID), msg.Allow); len(reason) > 0 {
+			s.SendConsoleMessage(s.localized("Lobby", "房间"), reason)
+		}
+	case Isaacpb.RequestHeader_LobbySetJoinable:
+		msg := Isaacpb.RequestLobbySetJoinable{}
+		if err := proto.Unmarshal(body, &msg); err != nil {
+			log.Print(err)
+			return errors.New("failed to parse LobbySetJoinable package")
+		}
+
+		if reason := s.SetLobbyJoinable(LobbyID(msg.LobbyID), msg.Joinable); len(reason) > 0 {
+			s.SendConsoleMessage(s.localized("Lobby", "房间"), reason)
+		}
+	case Isaacpb.RequestHeader_LobbyListSubscribe:
+		msg := Isaacpb.RequestLobbyListSubscribe{}
+		if err := proto.Unmarshal(body, &msg); err != nil {
+			log.Print(err)
+			return errors.New("failed to parse LobbyListSubscribe package")
+		}
+
+		log.Print("user ", s.name, "(", s.steamId, ") subscribe lobby list of group ", msg.LobbyGroup, ":", msg.Subscribe)
+		if !s.SubscribeLobbyList(msg.Subscribe, msg.LobbyGroup) {
+			return errors.New("failed to send lobby list snapshot")
+		}
+	case Isaacpb.RequestHeader_Matchmake:
+		msg := Isaacpb.RequestMatchmake{}
+		if err := proto.Unmarshal(body, &msg); err != nil {
+			log.Print(err)
+			return errors.New("failed to parse Matchmake package")
+		}
+
+		if !s.Matchmake(&msg) {
+			return errors.New("failed to send matchmake status")
+		}
+	case Isaacpb.RequestHeader_LobbyInviteCode:
+		msg := Isaacpb.RequestLobbyInviteCode{}
+		if err := proto.Unmarshal(body, &msg); err != nil {
+			log.Print(err)
+			return errors.New("failed to parse LobbyInviteCode package")
+		}
+
+		code, reason := s.ManageInviteCode(LobbyID(msg.LobbyId), msg.Action)
+		if len(reason) > 0 {
+			s.SendConsoleMessage(s.localized("Lobby", "房间"), reason)
+		}
+		if !s.SendPackage(Isaacpb.ResponseHeader_LobbyInviteCode, header.HoldValue, &Isaacpb.ResponseLobbyInviteCode{
+			LobbyId: msg.LobbyId,
+			Code:    code,
+		}) {
+			return errors.New("failed to send invite code package")
+		}
+	case Isaacpb.RequestHeader_JoinLobbyByCode:
+		msg := Isaacpb.RequestJoinLobbyByCode{}
+		if err := proto.Unmarshal(body, &msg); err != nil {
+			log.Print(err)
+			return errors.New("failed to parse JoinLobbyByCode package")
+		}
+		if s.currentLobby != LobbyID(0) {
+			return errors.New("user already in lobby")
+		}
+
+		return s.JoinLobbyByCode(&msg, header.HoldValue)
+	case Isaacpb.RequestHeader_UpdateLobbyData:
+		msg := Isaacpb.RequestUpdateLobbyData{}
+		if err := proto.Unmarshal(body, &msg); err != nil {
+			log.Print(err)
+			return errors.New("failed to parse UpdateLobbyData package")
+		}
+
+		return s.UpdateLobbyData(&msg, header.HoldValue)
+	case Isaacpb.RequestHeader_LobbyAnswerJoin:
+		msg := Isaacpb.RequestLobbyAnswerJoin{}
+		if err := proto.Unmarshal(body, &msg); err != nil {
+			log.Print(err)
+			return errors.New("failed to parse LobbyAnswerJoin package")
+		}
+
+		if reason := s.AnswerJoinRequest(LobbyID(msg.LobbyId), SteamID(msg.SteamId), msg.Accept); len(reason) > 0 {
+			s.SendConsoleMessage(s.localized("Lobby", "房间"), reason)
+		}
+	case Isaacpb.RequestHeader_Relation:
+		msg := Isaacpb.RequestRelation{}
+		if err := proto.Unmarshal(body, &msg); err != nil {
+			log.Print(err)
+			return errors.New("failed to parse Relation package")
+		}
+
+		if msg.Action != Isaacpb.RequestRelation_List {
+			if reason := s.ChangeRelation(msg.Action, msg.Kind, SteamID(msg.SteamId)); len(reason) > 0 {
+				s.SendConsoleMessage(s.localized("Friends", "好友"), reason)
+			}
+		}
+		if !s.SendRelationList(header.HoldValue) {
+			return errors.New("failed to send relation list package")
+		}
+	case Isaacpb.RequestHeader_PresenceQuery:
+		msg := Isaacpb.RequestPresenceQuery{}
+		if err := proto.Unmarshal(body, &msg); err != nil {
+			log.Print(err)
+			return errors.New("failed to parse PresenceQuery package")
+		}
+
+		if !s.QueryPresence(&msg, header.HoldValue) {
+			return errors.New("failed to send presence package")
+		}
+	case Isaacpb.RequestHeader_PresenceSubscribe:
+		msg := Isaacpb.RequestPresenceSubscribe{}
+		if err := proto.Unmarshal(body, &msg); err != nil {
+			log.Print(err)
+			return errors.New("failed to parse PresenceSubscribe package")
+		}
+
+		if !s.SubscribePresence(msg.Subscribe, header.HoldValue) {
+			return errors.New("failed to send presence package")
+		}
+	case Isaacpb.RequestHeader_JoinPlayer:
+		msg := Isaacpb.RequestJoinPlayer{}
+		if err := proto.Unmarshal(body, &msg); err != nil {
+			log.Print(err)
+			return errors.New("failed to parse JoinPlayer package")
+		}
+
+		return s.JoinPlayer(&msg, header.HoldValue)
+	case Isaacpb.RequestHeader_Whisper:
+		msg := Isaacpb.RequestWhisper{}
+		if err := proto.Unmarshal(body, &msg); err != nil {
+			log.Print(err)
+			return errors.New("failed to parse Whisper package")
+		}
+
+		s.Whisper(SteamID(msg.SteamIdTarget), msg.Message)
 	}
 	return nil
 }
 
+// handleJoinLobby runs the join checks and answers the join request, the password is not checked if the user is invited
+func (s *SessionData) handleJoinLobby(msg *Isaacpb.RequestJoinLobby, holdValue int32, invited bool) error {
+	log.Print("user ", s.name, "(", s.steamId, ") wants join lobby ", msg.LobbyID)
+	if s.currentLobby != LobbyID(0) {
+		log.Print("the user is already in lobby ", s.currentLobby, ", we will let the user leave")
+		return errors.New("user already in lobby")
+	}
+
+	lobbiesMutex.Lock()
+	lobby := lobbies[LobbyID(msg.LobbyID)]
+	lobbiesMutex.Unlock()
+
+	result, reason, failedCheck := runJoinChecks(&JoinRequest{
+		Session:  s,
+		LobbyID:  LobbyID(msg.LobbyID),
+		Lobby:    lobby,
+		Password: msg.Password,
+		Spectate: msg.Spectate,
+		Invited:  invited,
+	})
+	if result == Isaacpb.ResponseLobbyJoin_Success && !invited && !msg.Spectate && lobby.needsApproval(s.steamId) {
+		// answered later, when the owner decides
+		s.requestJoinApproval(lobby, msg, holdValue)
+		return nil
+	}
+	if result == Isaacpb.ResponseLobbyJoin_Success {
+		if msg.Spectate {
+			result = s.SpectateLobby(lobby)
+		} else if !s.JoinLobby(LobbyID(msg.LobbyID)) {
+			// someone else took the last slot
+			result, reason = Isaacpb.ResponseLobbyJoin_Full, s.localized("The lobby is full", "房间已满")
+		}
+	}
+
+	resp := Isaacpb.ResponseLobbyJoin{
+		LobbyId:               uint32(msg.LobbyID),
+		ChatRoomEnterResponse: uint32(result),
+		ChatPermissions:       1,
+	}
+	if result != Isaacpb.ResponseLobbyJoin_Success {
+		log.Print("user ", s.name, "(", s.steamId, ") can't join lobby ", msg.LobbyID, ": ", result, " by the check ", failedCheck)
+		resp.Locked = lobby != nil && lobby.password != nil
+		if len(reason) > 0 {
+			s.SendConsoleMessage(s.localized("Failed to join the lobby", "房间加入失败"), reason)
+		}
+		s.SendPackage(Isaacpb.ResponseHeader_LobbyJoin, holdValue, &resp)
+		return nil
+	}
+
+	resp.Info = lobby.ToProtobufLobbyInfoWithUserData()
+	if msg.Spectate {
+		s.SendUserInfos(lobby)
+		s.SendPackage(Isaacpb.ResponseHeader_LobbyJoin, holdValue, &resp)
+		return nil
+	}
+
+	lobby.SendUserInfoToAllUsers()
+	s.SendPackage(Isaacpb.ResponseHeader_LobbyJoin, holdValue, &resp)
+	lobby.SendPackageToAllUsers(Isaacpb.ResponseHeader_LobbyChatUpdate, 0, &Isaacpb.ResponseLobbyChatUpdate{
+		SteamIdLobby:               uint64(lobby.id),
+		SteamIdMakingChange:        0,
+		SteamIdUserChanged:         uint64(s.steamId),
+		SteamIdMakingChangeIsLobby: false,
+		SteamIdUserChangedIsLobby:  false,
+		ChatMemberStateChange:      Isaacpb.ResponseLobbyChatUpdate_Entered,
+		LobbyInfo:                  lobby.ToProtobufLobbyInfoWithUserData(),
+	}, s.steamId)
+	return nil
+}
+
 func (s *SessionData) Close() {
+	s.handleMutex.Lock()
+	defer s.handleMutex.Unlock()
+	// the queued tasks are dropped from now on
+	s.closed = true
+	if s.closing != nil {
+		close(s.closing)
+	}
 	log.Print("user ", s.name, "(", s.steamId, ") say bye-bye")
 	if s.steamId != 0 {
 		sessionsMutex.Lock()
 		delete(sessions, s.steamId)
 		sessionsMutex.Unlock()
+
+		s.JoinGlobalChat(false)
+		s.SubscribeLobbyList(false, 0)
+		s.SubscribePresence(false, 0)
+		removeMatchmakeTicket(s)
+		s.cancelJoinApproval()
+		s.notifyFriendPresence(false)
 	}
 	if s.currentLobby != 0 {
 		s.LeaveLobby()
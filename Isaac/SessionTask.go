@@ -0,0 +1,66 @@
+/*
+	IsaacPaperServer server program
+	Copyright (C) 2024  frto027
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU Affero General Public License as
+	published by the Free Software Foundation, either version 3 of the
+	License, or (at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU Affero General Public License for more details.
+
+	You should have received a copy of the GNU Affero General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package Isaac
+
+import "IsaacPaperServer/0xf7.top/IsaacPaperServer/Isaacpb"
+
+// The requests of a session are handled with its handleMutex held. The other goroutines (the lobby
+// owner, the admin, the lifecycle manager) don't change the session directly, they queue a task
+// with RunTask. The task runs with the handleMutex held, right away if the session is idle, or when
+// the current request is handled. Waiting for the lock instead could dead lock two owners.
+
+// HandleRequest handles a request from the session's own goroutine
+func (s *SessionData) HandleRequest(header *Isaacpb.RequestHeader, body []byte) error {
+	s.handleMutex.Lock()
+	err := s.HandlePackage(header, body)
+	s.handleMutex.Unlock()
+	s.runPendingTasks()
+	return err
+}
+
+// RunTask runs the task for the session, it is dropped if the session is closed before it runs.
+// The caller may hold the lobby locks, the task must not expect them.
+func (s *SessionData) RunTask(task func()) {
+	s.tasksMutex.Lock()
+	s.tasks = append(s.tasks, task)
+	s.tasksMutex.Unlock()
+	s.runPendingTasks()
+}
+
+func (s *SessionData) runPendingTasks() {
+	for {
+		s.tasksMutex.Lock()
+		pending := len(s.tasks) > 0
+		s.tasksMutex.Unlock()
+		// the holder of the lock checks the tasks again after it unlocks
+		if !pending || !s.handleMutex.TryLock() {
+			return
+		}
+		s.tasksMutex.Lock()
+		tasks := s.tasks
+		s.tasks = nil
+		s.tasksMutex.Unlock()
+		if !s.closed {
+			for _, task := range tasks {
+				task()
+			}
+		}
+		s.handleMutex.Unlock()
+	}
+}
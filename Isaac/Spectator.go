@@ -0,0 +1,270 @@
+/*
+	IsaacPaperServer server program
+	Copyright (C) 2024  frto027
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU Affero General Public License as
+	published by the Free Software Foundation, either version 3 of the
+	License, or (at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU Affero General Public License for more details.
+
+	You should have received a copy of the GNU Affero General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package Isaac
+
+import (
+	"IsaacPaperServer/0xf7.top/IsaacPaperServer/Isaacpb"
+	"errors"
+	"log"
+	"net"
+	"net/netip"
+	"sync"
+)
+
+// Spectators are in the lobby without a player position. They receive lobby data, chat,
+// member events and a copy of the relayed game traffic, but can't send anything into the game.
+
+var MaxLobbySpectators = 8
+var MaxLobbySpectatorsMutex = sync.Mutex{}
+
+func (L *LobbyData) HasSpectator(user SteamID) bool {
+	return L.SpectatorPosition(user) != -1
+}
+func (L *LobbyData) SpectatorPosition(user SteamID) int {
+	for i, u := range L.spectators {
+		if u == user {
+			return i
+		}
+	}
+	return -1
+}
+func (L *LobbyData) AddSpectator(user SteamID) error {
+	if L.HasSpectator(user) {
+		return nil
+	}
+	MaxLobbySpectatorsMutex.Lock()
+	maxSpectators := MaxLobbySpectators
+	MaxLobbySpectatorsMutex.Unlock()
+	if len(L.spectators) >= maxSpectators {
+		return errors.New("too many spectators")
+	}
+	// copy on write, the callers may keep the old slices after they unlock the lobby
+	L.spectators = append(append([]SteamID(nil), L.spectators...), user)
+	L.spectatorAddresses = append(append([]netip.AddrPort(nil), L.spectatorAddresses...), netip.AddrPort{})
+	return nil
+}
+func (L *LobbyData) RemoveSpectator(user SteamID) {
+	idx := L.SpectatorPosition(user)
+	if idx == -1 {
+		return
+	}
+	spectators := append([]SteamID(nil), L.spectators[:idx]...)
+	L.spectators = append(spectators, L.spectators[idx+1:]...)
+	addresses := append([]netip.AddrPort(nil), L.spectatorAddresses[:idx]...)
+	L.spectatorAddresses = append(addresses, L.spectatorAddresses[idx+1:]...)
+}
+
+// SpectateLobby is the spectator version of JoinLobby
+func (s *SessionData) SpectateLobby(L *LobbyData) Isaacpb.ResponseLobbyJoin_EChatRoomEnterResponse {
+	L.lobbyMutex.Lock()
+	if !L.allowSpectators {
+		L.lobbyMutex.Unlock()
+		s.SendConsoleMessage(s.localized("Failed to join the lobby", "房间加入失败"), s.localized(
+			"The lobby owner doesn't allow spectators", "房主不允许旁观"))
+		return Isaacpb.ResponseLobbyJoin_NotAllowed
+	}
+	err := L.AddSpectator(s.steamId)
+	L.lobbyMutex.Unlock()
+	if err != nil {
+		s.SendConsoleMessage(s.localized("Failed to join the lobby", "房间加入失败"), s.localized(
+			"There are too many spectators in the lobby", "房间中的旁观者太多了"))
+		return Isaacpb.ResponseLobbyJoin_Full
+	}
+
+	MarkLobbyDirty(L.id)
+
+	s.setLobbyState(L.id, true)
+	log.Print("user ", s.name, "(", s.steamId, ") is spectating lobby ", L.id)
+	L.SendConsoleMessageToAllUsers("Lobby", "房间", s.name+" is spectating", s.name+"正在旁观", s.steamId)
+	L.notifySpectatorChanged(s.steamId, s.steamId, Isaacpb.ResponseLobbyChatUpdate_Entered)
+	return Isaacpb.ResponseLobbyJoin_Success
+}
+
+// notifySpectatorChanged tells the others in the lobby that the spectator comes or goes, the
+// refreshed LobbyInfo carries the spectators
+func (L *LobbyData) notifySpectatorChanged(user SteamID, by SteamID, state Isaacpb.ResponseLobbyChatUpdate_ChatMemberStateChange) {
+	L.SendPackageToAllUsers(Isaacpb.ResponseHeader_LobbyChatUpdate, 0, &Isaacpb.ResponseLobbyChatUpdate{
+		SteamIdLobby:          uint64(L.id),
+		SteamIdUserChanged:    uint64(user),
+		SteamIdMakingChange:   uint64(by),
+		ChatMemberStateChange: state,
+		LobbyInfo:             L.ToProtobufLobbyInfoWithUserData(),
+	}, user)
+}
+
+func (L *LobbyData) SendConsoleMessageToAllUsers(captionEn string, captionZh string, en string, zh string, except SteamID) {
+	for _, userSteamId := range L.recipients() {
+		if userSteamId == except || userSteamId == 0 {
+			continue
+		}
+		sessionsMutex.Lock()
+		session, ok := sessions[userSteamId]
+		sessionsMutex.Unlock()
+		if !ok {
+			continue
+		}
+		session.SendConsoleMessage(session.localized(captionEn, captionZh), session.localized(en, zh))
+	}
+}
+
+func (s *SessionData) SetLobbySpectators(id LobbyID, allow bool) string {
+	L, reason := s.ownedLobby(id)
+	if L == nil {
+		return reason
+	}
+	L.lobbyMutex.Lock()
+	L.allowSpectators = allow
+	spectators := L.spectators
+	if !allow {
+		L.spectators = nil
+		L.spectatorAddresses = nil
+	}
+	L.lobbyMutex.Unlock()
+	MarkLobbyDirty(L.id)
+	log.Print("user ", s.name, "(", s.steamId, ") sets lobby ", L.id, " allow spectators:", allow)
+
+	if !allow {
+		for _, u := range spectators {
+			L.dropSpectator(u, Isaacpb.ResponseLobbyChatUpdate_Kicked)
+		}
+	}
+	return ""
+}
+
+// dropSpectator tells the spectator and the others that it is no longer in the lobby
+func (L *LobbyData) dropSpectator(user SteamID, state Isaacpb.ResponseLobbyChatUpdate_ChatMemberStateChange) {
+	MarkLobbyDirty(L.id)
+	L.lobbyMutex.Lock()
+	owner := L.owner
+	L.lobbyMutex.Unlock()
+	by := user
+	if state != Isaacpb.ResponseLobbyChatUpdate_Left {
+		by = owner
+	}
+	L.notifySpectatorChanged(user, by, state)
+
+	sessionsMutex.Lock()
+	other, ok := sessions[user]
+	sessionsMutex.Unlock()
+	if !ok {
+		return
+	}
+	other.RunTask(func() {
+		if other.currentLobby != L.id || !other.spectating {
+			return
+		}
+		other.setLobbyState(0, false)
+		other.removeUdpToken()
+		other.SendPackage(Isaacpb.ResponseHeader_LobbyChatUpdate, 0, &Isaacpb.ResponseLobbyChatUpdate{
+			SteamIdLobby:          uint64(L.id),
+			SteamIdUserChanged:    uint64(user),
+			SteamIdMakingChange:   uint64(owner),
+			ChatMemberStateChange: state,
+		})
+	})
+}
+
+// mirrorToSpectators sends a copy of the relayed udp package to the spectators
+func (L *LobbyData) mirrorToSpectators(bts []byte) {
+	L.lobbyMutex.Lock()
+	addresses := append([]netip.AddrPort(nil), L.spectatorAddresses...)
+	L.lobbyMutex.Unlock()
+	for _, addr := range addresses {
+		if addr.IsValid() {
+			_, _ = conn.WriteToUDP(bts, net.UDPAddrFromAddrPort(addr))
+		}
+	}
+}
+
+// the p2p packages mirrored to a spectator are queued, a slow spectator drops them instead of
+// blocking the player who sends them
+const spectatorQueueSize = 64
+
+type mirroredPackage struct {
+	source  SteamID
+	channel int32
+	data    []byte
+}
+
+// mirrorP2PPackage sends a copy of a p2p package to the spectators of the lobby
+func (L *LobbyData) mirrorP2PPackage(source SteamID, channel int32, data []byte) {
+	L.lobbyMutex.Lock()
+	spectators := append([]SteamID(nil), L.spectators...)
+	L.lobbyMutex.Unlock()
+	if len(spectators) == 0 {
+		return
+	}
+	p := mirroredPackage{source: source, channel: channel, data: append([]byte(nil), data...)}
+	for _, u := range spectators {
+		sessionsMutex.Lock()
+		other, ok := sessions[u]
+		sessionsMutex.Unlock()
+		if ok {
+			other.queueMirroredPackage(p)
+		}
+	}
+}
+
+func (s *SessionData) queueMirroredPackage(p mirroredPackage) {
+	s.mirrorOnce.Do(func() {
+		s.mirrorQueue = make(chan mirroredPackage, spectatorQueueSize)
+		go s.mirrorLoop()
+	})
+	select {
+	case s.mirrorQueue <- p:
+	default:
+		// the spectator can't keep up, it misses the package
+	}
+}
+
+// mirrorLoop writes the mirrored packages to the spectator until the session is closed
+func (s *SessionData) mirrorLoop() {
+	for {
+		select {
+		case <-s.closing:
+			return
+		case p := <-s.mirrorQueue:
+			s.sendMirroredPackage(p)
+		}
+	}
+}
+
+func (s *SessionData) sendMirroredPackage(p mirroredPackage) {
+	s.connSendMutex.Lock()
+	defer s.connSendMutex.Unlock()
+	if !s.SendPackageNoLock(Isaacpb.ResponseHeader_HasNewP2PPackage, 0, &Isaacpb.ResponseHasNewP2PPackage{
+		SteamIDSource: uint64(p.source),
+		DataSize:      uint32(len(p.data)),
+		Channel:       p.channel,
+	}) {
+		return
+	}
+	sent := 0
+	for sent < len(p.data) {
+		r, err := s.conn.Write(p.data[sent:])
+		if err != nil || r < 0 {
+			break
+		}
+		sent += r
+	}
+	// the tailing byte tells if the package is complete
+	if sent == len(p.data) {
+		_, _ = s.conn.Write([]byte{1})
+	}
+}
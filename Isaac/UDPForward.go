@@ -36,7 +36,8 @@ var clientsMutex = sync.Mutex{}
 
 type UDPWaitingClientItem struct {
 	lobby    LobbyID
-	position int
+	position int // -1 for spectators
+	user     SteamID
 }
 
 var waitingClients = map[string]UDPWaitingClientItem{}
@@ -51,23 +52,45 @@ func (C *UDPRemoteClient) onPackageReceive(bts []byte) {
 	switch bts[0] & 0xF0 {
 	case byte(Isaacpb.UdpMessageType_ForwardOrYours), byte(Isaacpb.UdpMessageType_ForwardOrYoursAndChannel),
 		byte(Isaacpb.UdpMessageType_EnsurePkg):
-		target := bts[0] & 0x3
-		lobbiesMutex.Lock()
-		lobby, ok := lobbies[C.lobbyId]
-		lobbiesMutex.Unlock()
-		if ok {
-			lobby.lobbyMutex.Lock()
-			targetAddr := lobby.udpAddresses[target]
-			lobby.lobbyMutex.Unlock()
-			if targetAddr.IsValid() {
-				_, _ = conn.WriteToUDP(bts, net.UDPAddrFromAddrPort(targetAddr))
-			}
+		C.forward(bts, int(bts[0]&0x3))
+	case byte(Isaacpb.UdpMessageType_ForwardExtended):
+		// positions above 3 don't fit in 2 bits, the dst is in the third byte
+		if len(bts) < 3 {
+			return
 		}
+		C.forward(bts, int(bts[2]))
 	case byte(Isaacpb.UdpMessageType_PingPong):
 		_, _ = conn.WriteToUDP([]byte{byte(Isaacpb.UdpMessageType_PingPong)}, net.UDPAddrFromAddrPort(C.addr))
 	}
 }
 
+func (C *UDPRemoteClient) forward(bts []byte, target int) {
+	lobbiesMutex.Lock()
+	lobby, ok := lobbies[C.lobbyId]
+	lobbiesMutex.Unlock()
+	if !ok {
+		return
+	}
+	lobby.lobbyMutex.Lock()
+	targetAddr := netip.AddrPort{}
+	if target < len(lobby.udpAddresses) {
+		targetAddr = lobby.udpAddresses[target]
+	}
+	// the sender may have left or been kicked from the lobby
+	isMember := false
+	for _, addr := range lobby.udpAddresses {
+		if addr == C.addr {
+			isMember = true
+		}
+	}
+	lobby.lobbyMutex.Unlock()
+	if isMember && targetAddr.IsValid() {
+		_, _ = conn.WriteToUDP(bts, net.UDPAddrFromAddrPort(targetAddr))
+		lobby.mirrorToSpectators(bts)
+		lobby.touch()
+	}
+}
+
 func ServeUdp(addr string) {
 	udpAddr, err := net.ResolveUDPAddr("udp", addr)
 	if err != nil {
@@ -108,16 +131,31 @@ func ServeUdp(addr string) {
 				lobbiesMutex.Lock()
 				L, ok := lobbies[lobby.lobby]
 				lobbiesMutex.Unlock()
-				if ok {
+				if ok && lobby.position == -1 {
+					L.lobbyMutex.Lock()
+					if idx := L.SpectatorPosition(lobby.user); idx != -1 {
+						addresses := append([]netip.AddrPort(nil), L.spectatorAddresses...)
+						addresses[idx] = addr.AddrPort()
+						L.spectatorAddresses = addresses
+					}
+					L.lobbyMutex.Unlock()
+
+					log.Print("spectator from address ", addr, " has connect udp socket.(lobby ", lobby.lobby, ")")
+
+					PingPongPkg := make([]byte, 1)
+					PingPongPkg[0] = byte(Isaacpb.UdpMessageType_PingPong)
+					_, _ = conn.WriteToUDP(PingPongPkg, addr)
+				} else if ok {
 					L.lobbyMutex.Lock()
 					L.udpAddresses[lobby.position] = addr.AddrPort()
+					L.touch()
+					L.lobbyMutex.Unlock()
 
 					L.SendPackageToAllUsers(Isaacpb.ResponseHeader_UpdateUserUdpIpAddr, 0, &Isaacpb.ResponseUserAddr{
 						Lobbypos:  int32(lobby.position),
 						UdpIpAddr: addr.IP,
 						UdpPort:   int32(addr.Port),
 					}, 0)
-					L.lobbyMutex.Unlock()
 
 					log.Print("user from address ", addr, " has connect udp socket.(lobby ",
 						lobby.lobby, ", ", lobby.position, ")")
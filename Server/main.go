@@ -25,13 +25,13 @@ import (
 	"log"
 	"os"
 	"regexp"
-	"time"
 )
 
 var AdminPswd = flag.String("p", "", "REQUIRED, server admin password")
 var TcpAddr = flag.String("t", "0.0.0.0:8555", "server tcp4 address/port, as well as admin port")
 var UdpAddr = flag.String("u", "0.0.0.0:8554", "server udp address/port, for p2p gameplay")
 var LogFile = flag.String("l", "-", "log file, \"-\" means stderr")
+var DataDir = flag.String("d", "", "data directory for the friend lists, the lobby templates, the permanent lobbies and the used lobby IDs, empty means they are not saved")
 
 func PrintUsage(_ string) error {
 	_, _ = fmt.Fprintln(flag.CommandLine.Output(), "Command line argument:")
@@ -65,20 +65,36 @@ func main() {
 
 	Isaac.LOGIN_PRIVILEDGE = *AdminPswd
 
+	if *DataDir != "" {
+		if err := Isaac.SetDataDir(*DataDir); err != nil {
+			log.Fatal(err)
+		}
+		if err := Isaac.LoadLobbyIDs(); err != nil {
+			log.Fatal(err)
+		}
+		if err := Isaac.LoadRelations(); err != nil {
+			log.Fatal(err)
+		}
+		if err := Isaac.LoadLobbyTemplates(); err != nil {
+			log.Fatal(err)
+		}
+		if err := Isaac.LoadPermanentLobbies(); err != nil {
+			log.Fatal(err)
+		}
+	} else {
+		log.Print("warning: no data directory is set (-d), the lobby IDs may be issued again after a restart")
+	}
+
 	if re, err := regexp.Compile("^$"); err == nil {
 		Isaac.TextFilterRe = re
 	}
 	go Isaac.ServeUdp(*UdpAddr)
+	go Isaac.LobbyListPushLoop()
+	go Isaac.MatchmakeLoop()
+	go Isaac.PresencePushLoop()
+	go Isaac.AdminAlertLoop()
 
-	go func() {
-		for {
-			time.Sleep(time.Minute * 10)
-			n := Isaac.DeleteOldLobbies()
-			if n != 0 {
-				log.Fatal("Delete ", n, " old lobbies.")
-			}
-		}
-	}()
+	go Isaac.LobbyLifecycleLoop()
 
 	Isaac.ServeForever("tcp4", *TcpAddr)
 }